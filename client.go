@@ -0,0 +1,566 @@
+package sawchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/eolatham/sawchain/internal/util"
+)
+
+// Client provides the Sawchain API for code that doesn't run in a test (e.g. standalone smoke-test
+// programs and helper goroutines). Operations behave like their Sawchain counterparts, but return errors
+// instead of failing a test. Use NewClient to create a Client instance.
+//
+// Each operation runs on its own goroutine, so failures never stop the calling goroutine, and operations
+// may be called concurrently. Operations that register resources for cleanup (e.g. Create and
+// EphemeralNamespace) defer their cleanup until Cleanup is called.
+//
+// Global state set by operations (i.e. generated names and the ephemeral namespace) is shared with
+// subsequent operations once the setting operation returns.
+//
+// Functions returned by *Func operations also return errors instead of failing, so they can be polled
+// with Gomega's Eventually or called directly. Gomega matcher constructors (e.g. MatchYAML) and generic
+// functions (e.g. Fetch) are not provided, since they are only useful in tests.
+type Client struct {
+	mu       sync.Mutex
+	s        *Sawchain
+	cleanups []recordedCleanup
+}
+
+type recordedCleanup struct {
+	r *failureRecorder
+	f func()
+}
+
+// failureRecorder is a testing.TB that records failures instead of reporting them to a test. It implements
+// every testing.TB method itself; the embedded testing.TB is always nil and only satisfies the interface's
+// unexported method. Like testing.T, fatal failures and skips exit the calling goroutine, so operations must
+// run on their own goroutines (see runRecorded).
+type failureRecorder struct {
+	testing.TB
+	mu       sync.Mutex
+	failures []string
+	skipped  bool
+	cleanup  func(r *failureRecorder, f func())
+}
+
+func (r *failureRecorder) record(failure string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failures = append(r.failures, failure)
+}
+
+// since returns an error joining all failures recorded after the first n, or nil if there are none.
+func (r *failureRecorder) since(n int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.failures) <= n {
+		return nil
+	}
+	return errors.New(strings.Join(r.failures[n:], "\n"))
+}
+
+func (r *failureRecorder) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.failures)
+}
+
+func (r *failureRecorder) Name() string {
+	return "sawchain.Client"
+}
+
+func (r *failureRecorder) Context() context.Context {
+	return context.Background()
+}
+
+func (r *failureRecorder) Output() io.Writer {
+	return io.Discard
+}
+
+func (r *failureRecorder) Helper() {}
+
+func (r *failureRecorder) Cleanup(f func()) {
+	r.cleanup(r, f)
+}
+
+func (r *failureRecorder) TempDir() string {
+	dir, err := os.MkdirTemp("", "sawchain-")
+	if err != nil {
+		r.Fatalf("failed to create temporary directory: %v", err)
+	}
+	r.Cleanup(func() {
+		if err := os.RemoveAll(dir); err != nil {
+			r.Errorf("failed to remove temporary directory: %v", err)
+		}
+	})
+	return dir
+}
+
+func (r *failureRecorder) ArtifactDir() string {
+	return r.TempDir()
+}
+
+func (r *failureRecorder) Setenv(key, value string) {
+	previous, existed := os.LookupEnv(key)
+	if err := os.Setenv(key, value); err != nil {
+		r.Fatalf("failed to set environment variable %s: %v", key, err)
+	}
+	r.Cleanup(func() {
+		if existed {
+			_ = os.Setenv(key, previous)
+		} else {
+			_ = os.Unsetenv(key)
+		}
+	})
+}
+
+func (r *failureRecorder) Chdir(dir string) {
+	previous, err := os.Getwd()
+	if err != nil {
+		r.Fatalf("failed to get working directory: %v", err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		r.Fatalf("failed to change working directory: %v", err)
+	}
+	r.Cleanup(func() {
+		if err := os.Chdir(previous); err != nil {
+			r.Errorf("failed to restore working directory: %v", err)
+		}
+	})
+}
+
+func (r *failureRecorder) Attr(key, value string) {}
+
+func (r *failureRecorder) Failed() bool {
+	return r.count() > 0
+}
+
+func (r *failureRecorder) Fail() {
+	r.record("failed")
+}
+
+func (r *failureRecorder) FailNow() {
+	r.record("failed")
+	runtime.Goexit()
+}
+
+func (r *failureRecorder) Error(args ...interface{}) {
+	r.record(fmt.Sprint(args...))
+}
+
+func (r *failureRecorder) Errorf(format string, args ...interface{}) {
+	r.record(fmt.Sprintf(format, args...))
+}
+
+func (r *failureRecorder) Fatal(args ...interface{}) {
+	r.record(fmt.Sprint(args...))
+	runtime.Goexit()
+}
+
+func (r *failureRecorder) Fatalf(format string, args ...interface{}) {
+	r.record(fmt.Sprintf(format, args...))
+	runtime.Goexit()
+}
+
+func (r *failureRecorder) Skip(args ...interface{}) {
+	r.skip(fmt.Sprint(args...))
+}
+
+func (r *failureRecorder) Skipf(format string, args ...interface{}) {
+	r.skip(fmt.Sprintf(format, args...))
+}
+
+func (r *failureRecorder) SkipNow() {
+	r.skip("")
+}
+
+// skip records the skip as a failure, since the operation didn't complete.
+func (r *failureRecorder) skip(message string) {
+	r.mu.Lock()
+	r.skipped = true
+	r.mu.Unlock()
+	failure := "skipped"
+	if message != "" {
+		failure += ": " + message
+	}
+	r.record(failure)
+	runtime.Goexit()
+}
+
+func (r *failureRecorder) Skipped() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.skipped
+}
+
+func (r *failureRecorder) Log(args ...interface{}) {}
+
+func (r *failureRecorder) Logf(format string, args ...interface{}) {}
+
+// runRecorded runs the operation on its own goroutine and returns an error joining any failures
+// it records with the recorder.
+func runRecorded(r *failureRecorder, operation func()) error {
+	n := r.count()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		operation()
+	}()
+	<-done
+	return r.since(n)
+}
+
+// NewClient creates a new Client instance with the provided global settings.
+//
+// Accepts the same arguments as New (except t). Invalid input will result in an error.
+//
+// # Examples
+//
+// Create a Client instance with the default settings:
+//
+//	sc, err := sawchain.NewClient(k8sClient)
+//
+// Create a Client instance with custom timeout and interval settings:
+//
+//	sc, err := sawchain.NewClient(k8sClient, "10s", "2s")
+func NewClient(c client.Client, args ...interface{}) (*Client, error) {
+	sc := &Client{}
+	r := sc.newRecorder()
+	var s *Sawchain
+	if err := runRecorded(r, func() { s = New(r, c, args...) }); err != nil {
+		return nil, err
+	}
+	sc.s = s
+	return sc, nil
+}
+
+func (c *Client) newRecorder() *failureRecorder {
+	return &failureRecorder{cleanup: c.registerCleanup}
+}
+
+func (c *Client) registerCleanup(r *failureRecorder, f func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cleanups = append(c.cleanups, recordedCleanup{r: r, f: f})
+}
+
+// run runs the operation with a Sawchain instance that records failures instead of failing a test,
+// then saves global state set by the operation for subsequent operations.
+func (c *Client) run(operation func(s *Sawchain)) error {
+	return c.runWith(c.newRecorder(), operation)
+}
+
+// runWith is like run, but records failures with the given recorder, which may be used to record
+// failures of functions returned by the operation (see recordedFunc).
+func (c *Client) runWith(r *failureRecorder, operation func(s *Sawchain)) error {
+	c.mu.Lock()
+	s := &Sawchain{t: r, g: gomega.NewWithT(r), c: c.s.c, opts: c.s.opts, namespace: c.s.namespace}
+	c.mu.Unlock()
+
+	err := runRecorded(r, func() { operation(s) })
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.s.opts.Bindings = util.MergeMapsDeep(c.s.opts.Bindings, s.opts.Bindings)
+	if c.s.namespace == "" {
+		c.s.namespace = s.namespace
+	}
+	return err
+}
+
+// recordedFunc wraps a function returned by an operation so that each call runs like an operation,
+// returning failures recorded with the operation's recorder as an error.
+func recordedFunc[T any](r *failureRecorder, f func() T) func() (T, error) {
+	return func() (T, error) {
+		var result T
+		err := runRecorded(r, func() { result = f() })
+		return result, err
+	}
+}
+
+// recordedErrFunc is like recordedFunc for functions that return an error, joining the recorded
+// failures and the returned error.
+func recordedErrFunc(r *failureRecorder, f func() error) func() error {
+	return func() error {
+		var fErr error
+		if err := runRecorded(r, func() { fErr = f() }); err != nil {
+			return err
+		}
+		return fErr
+	}
+}
+
+// Cleanup runs the cleanup registered by previous operations (e.g. deleting created resources and the
+// ephemeral namespace) in last-added, first-called order, and returns an error joining all cleanup
+// failures. Cleanup functions are only run once.
+//
+// # Examples
+//
+// Delete created resources when the program exits:
+//
+//	sc, err := sawchain.NewClient(k8sClient)
+//	if err != nil {
+//	  return err
+//	}
+//	defer sc.Cleanup()
+func (c *Client) Cleanup() error {
+	c.mu.Lock()
+	cleanups := c.cleanups
+	c.cleanups = nil
+	c.mu.Unlock()
+
+	var errs []error
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		errs = append(errs, runRecorded(cleanups[i].r, cleanups[i].f))
+	}
+	return errors.Join(errs...)
+}
+
+// EphemeralNamespace is like Sawchain.EphemeralNamespace, but returns an error instead of failing.
+// It should be called before running other operations concurrently.
+func (c *Client) EphemeralNamespace(ctx context.Context) (string, error) {
+	var namespace string
+	err := c.run(func(s *Sawchain) { namespace = s.EphemeralNamespace(ctx) })
+	return namespace, err
+}
+
+// Create is like Sawchain.Create, but returns an error instead of failing.
+func (c *Client) Create(ctx context.Context, args ...interface{}) error {
+	return c.run(func(s *Sawchain) { s.Create(ctx, args...) })
+}
+
+// CreateExpectError is like Sawchain.CreateExpectError, but returns an error instead of failing
+// (including when creation is not rejected), separately from the rejection returned by the API server.
+func (c *Client) CreateExpectError(ctx context.Context, args ...interface{}) (rejection, err error) {
+	err = c.run(func(s *Sawchain) { rejection = s.CreateExpectError(ctx, args...) })
+	return rejection, err
+}
+
+// Update is like Sawchain.Update, but returns an error instead of failing.
+func (c *Client) Update(ctx context.Context, args ...interface{}) error {
+	return c.run(func(s *Sawchain) { s.Update(ctx, args...) })
+}
+
+// UpdateExpectError is like Sawchain.UpdateExpectError, but returns an error instead of failing
+// (including when the update is not rejected), separately from the rejection returned by the API server.
+func (c *Client) UpdateExpectError(ctx context.Context, args ...interface{}) (rejection, err error) {
+	err = c.run(func(s *Sawchain) { rejection = s.UpdateExpectError(ctx, args...) })
+	return rejection, err
+}
+
+// Upsert is like Sawchain.Upsert, but returns an error instead of failing.
+func (c *Client) Upsert(ctx context.Context, args ...interface{}) error {
+	return c.run(func(s *Sawchain) { s.Upsert(ctx, args...) })
+}
+
+// Apply is like Sawchain.Apply, but returns an error instead of failing.
+func (c *Client) Apply(ctx context.Context, args ...interface{}) error {
+	return c.run(func(s *Sawchain) { s.Apply(ctx, args...) })
+}
+
+// Patch is like Sawchain.Patch, but returns an error instead of failing.
+func (c *Client) Patch(ctx context.Context, args ...interface{}) error {
+	return c.run(func(s *Sawchain) { s.Patch(ctx, args...) })
+}
+
+// Mutate is like Sawchain.Mutate, but returns an error instead of failing.
+func (c *Client) Mutate(ctx context.Context, args ...interface{}) error {
+	return c.run(func(s *Sawchain) { s.Mutate(ctx, args...) })
+}
+
+// Delete is like Sawchain.Delete, but returns an error instead of failing.
+func (c *Client) Delete(ctx context.Context, args ...interface{}) error {
+	return c.run(func(s *Sawchain) { s.Delete(ctx, args...) })
+}
+
+// Get is like Sawchain.Get, but returns an error for invalid input instead of failing.
+func (c *Client) Get(ctx context.Context, args ...interface{}) error {
+	var getErr error
+	if err := c.run(func(s *Sawchain) { getErr = s.Get(ctx, args...) }); err != nil {
+		return err
+	}
+	return getErr
+}
+
+// GetFunc is like Sawchain.GetFunc, but returns an error for invalid input instead of failing. The
+// returned function also returns an error instead of failing.
+func (c *Client) GetFunc(ctx context.Context, args ...interface{}) (func() error, error) {
+	r := c.newRecorder()
+	var getF func() error
+	if err := c.runWith(r, func(s *Sawchain) { getF = s.GetFunc(ctx, args...) }); err != nil {
+		return nil, err
+	}
+	return recordedErrFunc(r, getF), nil
+}
+
+// FetchSingle is like Sawchain.FetchSingle, but returns an error instead of failing.
+func (c *Client) FetchSingle(ctx context.Context, args ...interface{}) (client.Object, error) {
+	var obj client.Object
+	err := c.run(func(s *Sawchain) { obj = s.FetchSingle(ctx, args...) })
+	return obj, err
+}
+
+// FetchSingleFunc is like Sawchain.FetchSingleFunc, but returns an error instead of failing. The
+// returned function also returns an error instead of failing.
+func (c *Client) FetchSingleFunc(ctx context.Context, args ...interface{}) (func() (client.Object, error), error) {
+	r := c.newRecorder()
+	var fetchF func() client.Object
+	if err := c.runWith(r, func(s *Sawchain) { fetchF = s.FetchSingleFunc(ctx, args...) }); err != nil {
+		return nil, err
+	}
+	return recordedFunc(r, fetchF), nil
+}
+
+// FetchMultiple is like Sawchain.FetchMultiple, but returns an error instead of failing.
+func (c *Client) FetchMultiple(ctx context.Context, args ...interface{}) ([]client.Object, error) {
+	var objs []client.Object
+	err := c.run(func(s *Sawchain) { objs = s.FetchMultiple(ctx, args...) })
+	return objs, err
+}
+
+// FetchMultipleFunc is like Sawchain.FetchMultipleFunc, but returns an error instead of failing. The
+// returned function also returns an error instead of failing.
+func (c *Client) FetchMultipleFunc(ctx context.Context, args ...interface{}) (func() ([]client.Object, error), error) {
+	r := c.newRecorder()
+	var fetchF func() []client.Object
+	if err := c.runWith(r, func(s *Sawchain) { fetchF = s.FetchMultipleFunc(ctx, args...) }); err != nil {
+		return nil, err
+	}
+	return recordedFunc(r, fetchF), nil
+}
+
+// FetchList is like Sawchain.FetchList, but returns an error instead of failing.
+func (c *Client) FetchList(ctx context.Context, args ...interface{}) (client.ObjectList, error) {
+	var list client.ObjectList
+	err := c.run(func(s *Sawchain) { list = s.FetchList(ctx, args...) })
+	return list, err
+}
+
+// FetchListFunc is like Sawchain.FetchListFunc, but returns an error instead of failing. The returned
+// function also returns an error instead of failing.
+func (c *Client) FetchListFunc(ctx context.Context, args ...interface{}) (func() (client.ObjectList, error), error) {
+	r := c.newRecorder()
+	var fetchF func() client.ObjectList
+	if err := c.runWith(r, func(s *Sawchain) { fetchF = s.FetchListFunc(ctx, args...) }); err != nil {
+		return nil, err
+	}
+	return recordedFunc(r, fetchF), nil
+}
+
+// Check is like Sawchain.Check, but returns an error for invalid input instead of failing.
+func (c *Client) Check(ctx context.Context, args ...interface{}) error {
+	var checkErr error
+	if err := c.run(func(s *Sawchain) { checkErr = s.Check(ctx, args...) }); err != nil {
+		return err
+	}
+	return checkErr
+}
+
+// CheckFunc is like Sawchain.CheckFunc, but returns an error for invalid input instead of failing. The
+// returned function also returns an error instead of failing.
+func (c *Client) CheckFunc(ctx context.Context, args ...interface{}) (func() error, error) {
+	r := c.newRecorder()
+	var checkF func() error
+	if err := c.runWith(r, func(s *Sawchain) { checkF = s.CheckFunc(ctx, args...) }); err != nil {
+		return nil, err
+	}
+	return recordedErrFunc(r, checkF), nil
+}
+
+// EventuallyCheck is like Sawchain.EventuallyCheck, but returns an error instead of failing.
+func (c *Client) EventuallyCheck(ctx context.Context, args ...interface{}) error {
+	return c.run(func(s *Sawchain) { s.EventuallyCheck(ctx, args...) })
+}
+
+// ConsistentlyCheck is like Sawchain.ConsistentlyCheck, but returns an error instead of failing.
+func (c *Client) ConsistentlyCheck(ctx context.Context, args ...interface{}) error {
+	return c.run(func(s *Sawchain) { s.ConsistentlyCheck(ctx, args...) })
+}
+
+// CheckAll is like Sawchain.CheckAll, but returns an error for invalid input instead of failing.
+func (c *Client) CheckAll(ctx context.Context, args ...interface{}) ([]client.Object, error) {
+	var objs []client.Object
+	var checkErr error
+	if err := c.run(func(s *Sawchain) { objs, checkErr = s.CheckAll(ctx, args...) }); err != nil {
+		return nil, err
+	}
+	return objs, checkErr
+}
+
+// CheckAllFunc is like Sawchain.CheckAllFunc, but returns an error for invalid input instead of failing.
+// The returned function also returns an error instead of failing.
+func (c *Client) CheckAllFunc(ctx context.Context, args ...interface{}) (func() ([]client.Object, error), error) {
+	r := c.newRecorder()
+	var checkAllF func() ([]client.Object, error)
+	if err := c.runWith(r, func(s *Sawchain) { checkAllF = s.CheckAllFunc(ctx, args...) }); err != nil {
+		return nil, err
+	}
+	return func() ([]client.Object, error) {
+		var objs []client.Object
+		err := recordedErrFunc(r, func() (checkErr error) {
+			objs, checkErr = checkAllF()
+			return checkErr
+		})()
+		return objs, err
+	}, nil
+}
+
+// CheckAbsent is like Sawchain.CheckAbsent, but returns an error for invalid input instead of failing.
+func (c *Client) CheckAbsent(ctx context.Context, args ...interface{}) error {
+	var checkErr error
+	if err := c.run(func(s *Sawchain) { checkErr = s.CheckAbsent(ctx, args...) }); err != nil {
+		return err
+	}
+	return checkErr
+}
+
+// CheckAbsentFunc is like Sawchain.CheckAbsentFunc, but returns an error for invalid input instead of
+// failing. The returned function also returns an error instead of failing.
+func (c *Client) CheckAbsentFunc(ctx context.Context, args ...interface{}) (func() error, error) {
+	r := c.newRecorder()
+	var checkAbsentF func() error
+	if err := c.runWith(r, func(s *Sawchain) { checkAbsentF = s.CheckAbsentFunc(ctx, args...) }); err != nil {
+		return nil, err
+	}
+	return recordedErrFunc(r, checkAbsentF), nil
+}
+
+// RenderToObject is like Sawchain.RenderToObject, but returns an error instead of failing.
+func (c *Client) RenderToObject(obj client.Object, template string, bindings ...map[string]any) error {
+	return c.run(func(s *Sawchain) { s.RenderToObject(obj, template, bindings...) })
+}
+
+// RenderToObjects is like Sawchain.RenderToObjects, but returns an error instead of failing.
+func (c *Client) RenderToObjects(objs []client.Object, template string, bindings ...map[string]any) error {
+	return c.run(func(s *Sawchain) { s.RenderToObjects(objs, template, bindings...) })
+}
+
+// RenderToString is like Sawchain.RenderToString, but returns an error instead of failing.
+func (c *Client) RenderToString(template string, bindings ...map[string]any) (string, error) {
+	var rendered string
+	err := c.run(func(s *Sawchain) { rendered = s.RenderToString(template, bindings...) })
+	return rendered, err
+}
+
+// RenderToFile is like Sawchain.RenderToFile, but returns an error instead of failing.
+func (c *Client) RenderToFile(filepath, template string, bindings ...map[string]any) error {
+	return c.run(func(s *Sawchain) { s.RenderToFile(filepath, template, bindings...) })
+}
+
+// EventuallyMatch is like Sawchain.EventuallyMatch, but returns an error instead of failing.
+func (c *Client) EventuallyMatch(ctx context.Context, args ...interface{}) (client.Object, error) {
+	var obj client.Object
+	err := c.run(func(s *Sawchain) { obj = s.EventuallyMatch(ctx, args...) })
+	return obj, err
+}
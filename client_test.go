@@ -0,0 +1,155 @@
+package sawchain_test
+
+import (
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/eolatham/sawchain"
+	"github.com/eolatham/sawchain/internal/testutil"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		c  client.Client
+		sc *sawchain.Client
+	)
+
+	template := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+data:
+  key: value
+`
+
+	BeforeEach(func() {
+		c = testutil.NewStandardFakeClient()
+		var err error
+		sc, err = sawchain.NewClient(c, fastTimeout, fastInterval)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should return an error for invalid global settings", func() {
+		_, err := sawchain.NewClient(nil)
+		Expect(err).To(MatchError(ContainSubstring("client must not be nil")))
+		_, err = sawchain.NewClient(c, "5s", "1s", "2s")
+		Expect(err).To(MatchError(ContainSubstring("invalid arguments")))
+	})
+
+	It("should create, check, and fetch resources", func() {
+		Expect(sc.Create(ctx, template)).To(Succeed())
+		Expect(sc.Check(ctx, template)).To(Succeed())
+		obj, err := sc.FetchSingle(ctx, template)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj).To(BeAssignableToTypeOf(&corev1.ConfigMap{}))
+		Expect(obj.(*corev1.ConfigMap).Data).To(Equal(map[string]string{"key": "value"}))
+	})
+
+	It("should return errors instead of failing", func() {
+		Expect(sc.Create(ctx, template)).To(Succeed())
+		Expect(sc.Create(ctx, template)).To(MatchError(ContainSubstring("failed to create with template")))
+		Expect(sc.Check(ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+data:
+  key: other
+`)).To(MatchError(ContainSubstring("data.key: Invalid value")))
+		Expect(sc.Update(ctx, "invalid: [")).To(MatchError(ContainSubstring("invalid template/bindings")))
+	})
+
+	It("should share generated names with subsequent operations", func() {
+		Expect(sc.Create(ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  generateName: test-
+  namespace: default
+`)).To(Succeed())
+		obj, err := sc.FetchSingle(ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ($generatedNames."test-")
+  namespace: default
+`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj.GetName()).To(HavePrefix("test-"))
+	})
+
+	It("should delete created resources on cleanup", func() {
+		Expect(sc.Create(ctx, template)).To(Succeed())
+		Expect(sc.Cleanup()).To(Succeed())
+		err := c.Get(ctx, client.ObjectKey{Name: "test-cm", Namespace: "default"}, &corev1.ConfigMap{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue(), "expected resource to be deleted: %v", err)
+		Expect(sc.Cleanup()).To(Succeed())
+	})
+
+	It("should run operations concurrently from goroutines", func() {
+		names := []string{"cm-a", "cm-b", "cm-c", "cm-d"}
+		errs := make([]error, len(names))
+		var wg sync.WaitGroup
+		for i, name := range names {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				errs[i] = sc.Create(ctx, testutil.NewConfigMap(name, "default", nil))
+			}()
+		}
+		wg.Wait()
+		for _, err := range errs {
+			Expect(err).NotTo(HaveOccurred())
+		}
+		for _, name := range names {
+			Expect(sc.Get(ctx, testutil.NewConfigMap(name, "default", nil))).To(Succeed())
+		}
+	})
+	It("should return rejections from expect error operations", func() {
+		Expect(sc.Create(ctx, template)).To(Succeed())
+		rejection, err := sc.CreateExpectError(ctx, template)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apierrors.IsAlreadyExists(rejection)).To(BeTrue(), "expected AlreadyExists rejection: %v", rejection)
+
+		_, err = sc.CreateExpectError(ctx, testutil.NewConfigMap("other-cm", "default", nil))
+		Expect(err).To(MatchError(ContainSubstring("expected create to be rejected")))
+
+		rejection, err = sc.UpdateExpectError(ctx, testutil.NewConfigMap("missing-cm", "default", nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apierrors.IsNotFound(rejection)).To(BeTrue(), "expected NotFound rejection: %v", rejection)
+	})
+
+	It("should return functions that return errors instead of failing", func() {
+		checkF, err := sc.CheckFunc(ctx, template)
+		Expect(err).NotTo(HaveOccurred())
+		fetchF, err := sc.FetchSingleFunc(ctx, template)
+		Expect(err).NotTo(HaveOccurred())
+		listF, err := sc.FetchListFunc(ctx, &corev1.ConfigMapList{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(checkF()).To(MatchError(ContainSubstring("actual resource not found")))
+		_, err = fetchF()
+		Expect(err).To(MatchError(ContainSubstring("failed to get with template")))
+
+		Expect(sc.Create(ctx, template)).To(Succeed())
+		Eventually(checkF).Should(Succeed())
+		obj, err := fetchF()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj.GetName()).To(Equal("test-cm"))
+		list, err := listF()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.(*corev1.ConfigMapList).Items).To(HaveLen(1))
+
+		_, err = sc.CheckAllFunc(ctx, template, 5)
+		Expect(err).To(MatchError(ContainSubstring("unexpected argument type: int")))
+	})
+})
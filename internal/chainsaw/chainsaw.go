@@ -2,21 +2,23 @@ package chainsaw
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
 	"github.com/kyverno/chainsaw/pkg/apis"
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/engine/bindings"
-	"github.com/kyverno/chainsaw/pkg/engine/checks"
 	operrors "github.com/kyverno/chainsaw/pkg/engine/operations/errors"
 	"github.com/kyverno/chainsaw/pkg/engine/templating"
 	"github.com/kyverno/chainsaw/pkg/loaders/resource"
+	"github.com/kyverno/kyverno-json/pkg/core/assertion"
+	extyaml "github.com/kyverno/pkg/ext/yaml"
 	"go.uber.org/multierr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 )
 
 type Bindings = apis.Bindings
@@ -44,6 +46,20 @@ func parseTemplate(templateContent string) ([]unstructured.Unstructured, error)
 	return objs, nil
 }
 
+// SplitDocuments splits the template into its non-empty YAML documents using a YAML stream reader,
+// so separators within block scalars or quoted strings don't split documents.
+func SplitDocuments(templateContent string) ([]string, error) {
+	split, err := extyaml.SplitDocuments([]byte(templateContent))
+	if err != nil {
+		return nil, fmt.Errorf("failed to split template documents: %w", err)
+	}
+	documents := make([]string, len(split))
+	for i, document := range split {
+		documents[i] = string(document)
+	}
+	return documents, nil
+}
+
 // RenderTemplate renders the template into unstructured objects (and processes template expressions).
 // Bindings are injected as is without type conversions, even when the template wraps them in quotes.
 func RenderTemplate(
@@ -85,6 +101,98 @@ func RenderTemplateSingle(
 	return rendered[0], nil
 }
 
+// RenderJSONPatch renders the template into an RFC 6902 JSON patch document (and processes template
+// expressions). The template must contain a list of patch operations.
+func RenderJSONPatch(
+	ctx context.Context,
+	templateContent string,
+	bindings Bindings,
+) ([]byte, error) {
+	var operations []any
+	if err := yaml.Unmarshal([]byte(templateContent), &operations); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON patch template: %w", err)
+	}
+	rendered, err := templating.Template(ctx, compilers, v1alpha1.NewProjection(operations), nil, bindings)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(normalize(rendered))
+}
+
+// normalize converts maps with interface keys produced by
+// template processing into JSON-compatible maps with string keys.
+func normalize(value any) any {
+	switch v := value.(type) {
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for key, val := range v {
+			m[fmt.Sprint(key)] = normalize(val)
+		}
+		return m
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for key, val := range v {
+			m[key] = normalize(val)
+		}
+		return m
+	case []any:
+		s := make([]any, len(v))
+		for i, val := range v {
+			s[i] = normalize(val)
+		}
+		return s
+	default:
+		return v
+	}
+}
+
+// Expectation is an expected resource with its assertion tree compiled
+// for matching candidates repeatedly (e.g. on each poll of an eventual check).
+type Expectation struct {
+	// Expected resource rendered from a template.
+	Resource  unstructured.Unstructured
+	assertion assertion.Assertion
+}
+
+// CompileExpectation compiles the assertion tree of the expected resource.
+func CompileExpectation(expected unstructured.Unstructured) (Expectation, error) {
+	check := v1alpha1.NewCheck(expected.UnstructuredContent())
+	if check.IsNil() {
+		return Expectation{}, errors.New("check value is null")
+	}
+	compiled, err := check.Compile(nil, compilers)
+	if err != nil {
+		return Expectation{}, err
+	}
+	return Expectation{Resource: expected, assertion: compiled}, nil
+}
+
+// RenderExpectation renders the single-resource template into an expected resource
+// and compiles its assertion tree.
+func RenderExpectation(ctx context.Context, templateContent string, bindings Bindings) (Expectation, error) {
+	expected, err := RenderTemplateSingle(ctx, templateContent, bindings)
+	if err != nil {
+		return Expectation{}, err
+	}
+	return CompileExpectation(expected)
+}
+
+// mismatch evaluates the expectation against the candidate, returning
+// an error describing the mismatch or nil if the candidate matches.
+func (e Expectation) mismatch(candidate unstructured.Unstructured, bindings Bindings) (mismatch error, err error) {
+	if bindings == nil {
+		bindings = apis.NewBindings()
+	}
+	fieldErrs, err := e.assertion.Assert(nil, candidate.UnstructuredContent(), bindings)
+	if err != nil {
+		return nil, err
+	}
+	if len(fieldErrs) != 0 {
+		return operrors.ResourceError(compilers, e.Resource, candidate, true, bindings, fieldErrs), nil
+	}
+	return nil, nil
+}
+
 // Match compares candidates with the expectation and returns the first match
 // or an error if no match is found. Does not handle non-resource matching.
 // Based on github.com/kyverno/chainsaw/pkg/engine/operations/assert.Exec.
@@ -93,18 +201,29 @@ func Match(
 	candidates []unstructured.Unstructured,
 	expected unstructured.Unstructured,
 	bindings Bindings,
+) (unstructured.Unstructured, error) {
+	expectation, err := CompileExpectation(expected)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	return MatchExpectation(ctx, candidates, expectation, bindings)
+}
+
+// MatchExpectation is like Match, but uses a compiled expectation.
+func MatchExpectation(
+	ctx context.Context,
+	candidates []unstructured.Unstructured,
+	expectation Expectation,
+	bindings Bindings,
 ) (unstructured.Unstructured, error) {
 	var errs []error
 	for _, candidate := range candidates {
-		fieldErrs, err := checks.Check(ctx, compilers, candidate.UnstructuredContent(), bindings,
-			ptr.To(v1alpha1.NewCheck(expected.UnstructuredContent())))
+		mismatch, err := expectation.mismatch(candidate, bindings)
 		if err != nil {
 			return unstructured.Unstructured{}, err
 		}
-		if len(fieldErrs) != 0 {
-			errs = append(errs,
-				operrors.ResourceError(compilers, expected, candidate, true, bindings, fieldErrs),
-			)
+		if mismatch != nil {
+			errs = append(errs, mismatch)
 		} else {
 			// Match found
 			return candidate, nil
@@ -113,9 +232,48 @@ func Match(
 	return unstructured.Unstructured{}, multierr.Combine(errs...)
 }
 
-// listCandidates lists resources in the cluster that might match the expectation.
+// MatchAll compares all candidates with the expectation and returns the matches along with the
+// mismatch errors of all other candidates. Does not handle non-resource matching.
+func MatchAll(
+	ctx context.Context,
+	candidates []unstructured.Unstructured,
+	expected unstructured.Unstructured,
+	bindings Bindings,
+) ([]unstructured.Unstructured, []error, error) {
+	expectation, err := CompileExpectation(expected)
+	if err != nil {
+		return nil, nil, err
+	}
+	return MatchAllExpectation(ctx, candidates, expectation, bindings)
+}
+
+// MatchAllExpectation is like MatchAll, but uses a compiled expectation.
+func MatchAllExpectation(
+	ctx context.Context,
+	candidates []unstructured.Unstructured,
+	expectation Expectation,
+	bindings Bindings,
+) ([]unstructured.Unstructured, []error, error) {
+	var matches []unstructured.Unstructured
+	var mismatches []error
+	for _, candidate := range candidates {
+		mismatch, err := expectation.mismatch(candidate, bindings)
+		if err != nil {
+			return nil, nil, err
+		}
+		if mismatch != nil {
+			mismatches = append(mismatches, mismatch)
+		} else {
+			matches = append(matches, candidate)
+		}
+	}
+	return matches, mismatches, nil
+}
+
+// ListCandidates lists resources in the cluster that might match the expectation. If the expectation
+// has no name, lists resources of its kind matching its namespace (if any) and labels (if any).
 // Based on github.com/kyverno/chainsaw/pkg/engine/operations/internal.Read.
-func listCandidates(
+func ListCandidates(
 	c client.Client,
 	ctx context.Context,
 	expected client.Object,
@@ -157,14 +315,22 @@ func Check(
 	templateContent string,
 	bindings Bindings,
 ) (unstructured.Unstructured, error) {
-	// Render expected resource
-	expected, err := RenderTemplateSingle(ctx, templateContent, bindings)
+	expectation, err := RenderExpectation(ctx, templateContent, bindings)
 	if err != nil {
 		return unstructured.Unstructured{}, err
 	}
+	return CheckExpectation(c, ctx, expectation, bindings)
+}
 
+// CheckExpectation is like Check, but uses a compiled expectation instead of rendering a template.
+func CheckExpectation(
+	c client.Client,
+	ctx context.Context,
+	expectation Expectation,
+	bindings Bindings,
+) (unstructured.Unstructured, error) {
 	// List candidates
-	candidates, err := listCandidates(c, ctx, &expected)
+	candidates, err := ListCandidates(c, ctx, &expectation.Resource)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			return unstructured.Unstructured{}, errors.New("actual resource not found")
@@ -176,5 +342,40 @@ func Check(
 	}
 
 	// Return first match
-	return Match(ctx, candidates, expected, bindings)
+	return MatchExpectation(ctx, candidates, expectation, bindings)
+}
+
+// CheckAll is like Check, but returns all matching resources (possibly none) along with the mismatch
+// errors of all other candidates. A named resource that doesn't exist has no matches.
+func CheckAll(
+	c client.Client,
+	ctx context.Context,
+	templateContent string,
+	bindings Bindings,
+) ([]unstructured.Unstructured, []error, error) {
+	expectation, err := RenderExpectation(ctx, templateContent, bindings)
+	if err != nil {
+		return nil, nil, err
+	}
+	return CheckAllExpectation(c, ctx, expectation, bindings)
+}
+
+// CheckAllExpectation is like CheckAll, but uses a compiled expectation instead of rendering a template.
+func CheckAllExpectation(
+	c client.Client,
+	ctx context.Context,
+	expectation Expectation,
+	bindings Bindings,
+) ([]unstructured.Unstructured, []error, error) {
+	// List candidates
+	candidates, err := ListCandidates(c, ctx, &expectation.Resource)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	// Return all matches
+	return MatchAllExpectation(ctx, candidates, expectation, bindings)
 }
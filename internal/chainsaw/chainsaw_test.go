@@ -8,8 +8,10 @@ import (
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	. "github.com/eolatham/sawchain/internal/chainsaw"
+	"github.com/eolatham/sawchain/internal/testutil"
 )
 
 var _ = Describe("Chainsaw", func() {
@@ -68,6 +70,53 @@ var _ = Describe("Chainsaw", func() {
 		)
 	})
 
+	Describe("SplitDocuments", func() {
+		DescribeTable("splitting templates into documents",
+			func(templateContent string, expectedDocuments []string) {
+				documents, err := SplitDocuments(templateContent)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(documents).To(HaveLen(len(expectedDocuments)))
+				for i, expected := range expectedDocuments {
+					var actualContent, expectedContent map[string]any
+					Expect(yaml.Unmarshal([]byte(documents[i]), &actualContent)).To(Succeed())
+					Expect(yaml.Unmarshal([]byte(expected), &expectedContent)).To(Succeed())
+					Expect(actualContent).To(Equal(expectedContent))
+				}
+			},
+			Entry("should handle empty template", ``, []string{}),
+			Entry("should return a single document", `
+kind: ConfigMap
+`, []string{"kind: ConfigMap"}),
+			Entry("should split multiple documents", `
+kind: ConfigMap
+---
+kind: Secret
+`, []string{"kind: ConfigMap", "kind: Secret"}),
+			Entry("should ignore leading, trailing, and repeated separators", `---
+kind: ConfigMap
+---
+---
+kind: Secret
+---
+`, []string{"kind: ConfigMap", "kind: Secret"}),
+			Entry("should not split on separators within block scalars or strings", `
+kind: ConfigMap
+data:
+  script: |
+    echo start
+    ---
+    echo end
+  separator: "a---b"
+`, []string{`kind: ConfigMap
+data:
+  script: |
+    echo start
+    ---
+    echo end
+  separator: "a---b"`}),
+		)
+	})
+
 	Describe("RenderTemplate", func() {
 		type testCase struct {
 			templateContent string
@@ -572,6 +621,68 @@ data:
 		)
 	})
 
+	Describe("RenderJSONPatch", func() {
+		type testCase struct {
+			templateContent string
+			bindings        map[string]any
+			expectedPatch   string
+			expectedErrs    []string
+		}
+
+		DescribeTable("rendering JSON patch templates",
+			func(tc testCase) {
+				patch, err := RenderJSONPatch(context.Background(), tc.templateContent, BindingsFromMap(tc.bindings))
+				if len(tc.expectedErrs) > 0 {
+					Expect(err).To(HaveOccurred())
+					for _, expectedErr := range tc.expectedErrs {
+						Expect(err.Error()).To(ContainSubstring(expectedErr))
+					}
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(patch).To(MatchJSON(tc.expectedPatch))
+				}
+			},
+			Entry("should render static operations", testCase{
+				templateContent: `
+- op: remove
+  path: /data/key
+`,
+				expectedPatch: `[{"op": "remove", "path": "/data/key"}]`,
+			}),
+			Entry("should render operations with bindings", testCase{
+				templateContent: `
+- op: replace
+  path: /data/key
+  value: ($value)
+- op: add
+  path: /metadata/labels
+  value:
+    app: (join('-', [$prefix, 'app']))
+`,
+				bindings: map[string]any{"value": "rendered", "prefix": "test"},
+				expectedPatch: `[
+  {"op": "replace", "path": "/data/key", "value": "rendered"},
+  {"op": "add", "path": "/metadata/labels", "value": {"app": "test-app"}}
+]`,
+			}),
+			Entry("should fail with non-list template", testCase{
+				templateContent: `
+op: remove
+path: /data/key
+`,
+				expectedErrs: []string{"failed to parse JSON patch template"},
+			}),
+			Entry("should fail with missing binding", testCase{
+				templateContent: `
+- op: replace
+  path: /data/key
+  value: ($missing)
+`,
+				expectedErrs: []string{"variable not defined: $missing"},
+			}),
+		)
+	})
+
 	Describe("Match", func() {
 		type testCase struct {
 			candidates    []unstructured.Unstructured
@@ -1041,6 +1152,246 @@ data:
 		)
 	})
 
+	Describe("MatchAll", func() {
+		newConfigMap := func(name, value string) unstructured.Unstructured {
+			return unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "ConfigMap",
+					"metadata": map[string]interface{}{
+						"name":      name,
+						"namespace": "default",
+					},
+					"data": map[string]interface{}{
+						"key1": value,
+					},
+				},
+			}
+		}
+
+		expected := unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"data": map[string]interface{}{
+					"key1": "($value)",
+				},
+			},
+		}
+
+		DescribeTable("matching all resources against expectations",
+			func(candidates []unstructured.Unstructured, expectedMatches []string, expectedMismatches []string) {
+				bindings := BindingsFromMap(map[string]any{"value": "expected-value"})
+				matches, mismatches, err := MatchAll(context.Background(), candidates, expected, bindings)
+				Expect(err).NotTo(HaveOccurred())
+				matchNames := make([]string, len(matches))
+				for i, match := range matches {
+					matchNames[i] = match.GetName()
+				}
+				Expect(matchNames).To(Equal(expectedMatches))
+				Expect(mismatches).To(HaveLen(len(expectedMismatches)))
+				for i, mismatch := range mismatches {
+					Expect(mismatch.Error()).To(ContainSubstring(expectedMismatches[i]))
+				}
+			},
+			Entry("should return no matches for empty candidates",
+				[]unstructured.Unstructured{}, []string{}, []string{}),
+			Entry("should return all matches in order",
+				[]unstructured.Unstructured{
+					newConfigMap("cm-1", "expected-value"),
+					newConfigMap("cm-2", "wrong-value"),
+					newConfigMap("cm-3", "expected-value"),
+				},
+				[]string{"cm-1", "cm-3"},
+				[]string{"v1/ConfigMap/default/cm-2"}),
+			Entry("should return all mismatches when nothing matches",
+				[]unstructured.Unstructured{
+					newConfigMap("cm-1", "wrong-value-1"),
+					newConfigMap("cm-2", "wrong-value-2"),
+				},
+				[]string{},
+				[]string{"v1/ConfigMap/default/cm-1", "v1/ConfigMap/default/cm-2"}),
+		)
+	})
+
+	Describe("Expectation", func() {
+		template := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+data:
+  key1: ($value)
+`
+
+		It("should match candidates repeatedly with a compiled expectation", func() {
+			bindings := BindingsFromMap(map[string]any{"value": "expected-value"})
+			expectation, err := RenderExpectation(ctx, template, bindings)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expectation.Resource.GetName()).To(Equal("test-cm"))
+
+			matching := unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "test-cm", "namespace": "default"},
+				"data":       map[string]interface{}{"key1": "expected-value"},
+			}}
+			mismatching := *matching.DeepCopy()
+			Expect(unstructured.SetNestedField(mismatching.Object, "wrong-value", "data", "key1")).To(Succeed())
+
+			for i := 0; i < 3; i++ {
+				match, err := MatchExpectation(ctx, []unstructured.Unstructured{mismatching, matching}, expectation, bindings)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(match).To(Equal(matching))
+				_, err = MatchExpectation(ctx, []unstructured.Unstructured{mismatching}, expectation, bindings)
+				Expect(err).To(MatchError(ContainSubstring("data.key1: Invalid value: \"wrong-value\"")))
+			}
+		})
+
+		It("should fail to render an invalid template", func() {
+			_, err := RenderExpectation(ctx, template, nil)
+			Expect(err).To(MatchError(ContainSubstring("variable not defined: $value")))
+		})
+	})
+
+	Describe("ListCandidates", func() {
+		type testCase struct {
+			resourcesYaml string
+			expectedYaml  string
+			expectedNames []string
+			expectedErr   string
+		}
+
+		DescribeTable("listing candidate resources",
+			func(tc testCase) {
+				// Create resources
+				c := testutil.NewStandardFakeClient()
+				resources, err := RenderTemplate(ctx, tc.resourcesYaml, nil)
+				Expect(err).NotTo(HaveOccurred(), "Failed to parse test resources")
+				for _, resource := range resources {
+					Expect(c.Create(ctx, &resource)).To(Succeed(), "Failed to create test resource")
+				}
+
+				// Test ListCandidates
+				expected, err := RenderTemplateSingle(ctx, tc.expectedYaml, nil)
+				Expect(err).NotTo(HaveOccurred(), "Failed to parse expectation")
+				candidates, err := ListCandidates(c, ctx, &expected)
+				if tc.expectedErr != "" {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(tc.expectedErr))
+					return
+				}
+				Expect(err).NotTo(HaveOccurred())
+				names := make([]string, len(candidates))
+				for i, candidate := range candidates {
+					names[i] = candidate.GetName()
+				}
+				Expect(names).To(ConsistOf(tc.expectedNames))
+			},
+
+			Entry("should get resource by name", testCase{
+				resourcesYaml: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm1
+  namespace: default
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm2
+  namespace: default
+`,
+				expectedYaml: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm1
+  namespace: default
+`,
+				expectedNames: []string{"cm1"},
+			}),
+
+			Entry("should list resources by namespace and labels without name", testCase{
+				resourcesYaml: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm1
+  namespace: default
+  labels:
+    app: foo
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm2
+  namespace: default
+  labels:
+    app: bar
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm3
+  namespace: other
+  labels:
+    app: foo
+`,
+				expectedYaml: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  namespace: default
+  labels:
+    app: foo
+`,
+				expectedNames: []string{"cm1"},
+			}),
+
+			Entry("should list resources across namespaces without name or namespace", testCase{
+				resourcesYaml: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm1
+  namespace: default
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm2
+  namespace: other
+`,
+				expectedYaml: `
+apiVersion: v1
+kind: ConfigMap
+`,
+				expectedNames: []string{"cm1", "cm2"},
+			}),
+
+			Entry("should fail to get missing resource by name", testCase{
+				resourcesYaml: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm1
+  namespace: default
+`,
+				expectedYaml: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: missing
+  namespace: default
+`,
+				expectedErr: "not found",
+			}),
+		)
+	})
+
 	Describe("Check", func() {
 		type testCase struct {
 			resourcesYaml   string
@@ -1533,4 +1884,59 @@ metadata:
 			}),
 		)
 	})
+
+	Describe("CheckAll", func() {
+		var c client.Client
+
+		BeforeEach(func() {
+			c = testutil.NewStandardFakeClient()
+			for _, name := range []string{"cm-1", "cm-2", "cm-3"} {
+				value := "expected-value"
+				if name == "cm-2" {
+					value = "wrong-value"
+				}
+				Expect(c.Create(ctx, testutil.NewConfigMap(name, "default", map[string]string{"key1": value}))).To(Succeed())
+			}
+		})
+
+		It("should return all matching resources and mismatches", func() {
+			matches, mismatches, err := CheckAll(c, ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  namespace: default
+data:
+  key1: expected-value
+`, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matches).To(HaveLen(2))
+			Expect(matches[0].GetName()).To(Equal("cm-1"))
+			Expect(matches[1].GetName()).To(Equal("cm-3"))
+			Expect(mismatches).To(HaveLen(1))
+			Expect(mismatches[0].Error()).To(ContainSubstring("v1/ConfigMap/default/cm-2"))
+		})
+
+		It("should return no matches for a missing named resource", func() {
+			matches, mismatches, err := CheckAll(c, ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm-missing
+  namespace: default
+`, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matches).To(BeEmpty())
+			Expect(mismatches).To(BeEmpty())
+		})
+
+		It("should fail on invalid template", func() {
+			_, _, err := CheckAll(c, ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ($missing_binding)
+`, nil)
+			Expect(err).To(MatchError(ContainSubstring("variable not defined: $missing_binding")))
+		})
+	})
 })
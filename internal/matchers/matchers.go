@@ -8,6 +8,8 @@ import (
 
 	"github.com/onsi/gomega/format"
 	"github.com/onsi/gomega/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -24,6 +26,8 @@ type chainsawMatcher struct {
 	createTemplateContent func(c client.Client, obj client.Object) (string, error)
 	// Current template content.
 	templateContent string
+	// Expectation compiled from the current template content (rendered only when the content changes).
+	expectation *chainsaw.Expectation
 	// Template bindings.
 	bindings chainsaw.Bindings
 	// Template bindings map.
@@ -44,15 +48,21 @@ func (m *chainsawMatcher) Match(actual interface{}) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	m.templateContent, err = m.createTemplateContent(m.c, obj)
+	templateContent, err := m.createTemplateContent(m.c, obj)
 	if err != nil {
 		return false, err
 	}
-	expected, err := chainsaw.RenderTemplateSingle(context.TODO(), m.templateContent, m.bindings)
-	if err != nil {
-		return false, err
+	if m.expectation == nil || templateContent != m.templateContent {
+		m.templateContent = templateContent
+		m.expectation = nil
+		expectation, err := chainsaw.RenderExpectation(context.TODO(), m.templateContent, m.bindings)
+		if err != nil {
+			return false, err
+		}
+		m.expectation = &expectation
 	}
-	_, m.matchError = chainsaw.Match(context.TODO(), []unstructured.Unstructured{candidate}, expected, m.bindings)
+	_, m.matchError = chainsaw.MatchExpectation(
+		context.TODO(), []unstructured.Unstructured{candidate}, *m.expectation, m.bindings)
 	return m.matchError == nil, nil
 }
 
@@ -122,3 +132,91 @@ status:
 		},
 	}
 }
+
+// statusErrorMatcher is a Gomega matcher that checks if
+// an error is a K8s API status error with expected details.
+type statusErrorMatcher struct {
+	// Description of the expected details.
+	expected string
+	// Function to check the status of the error.
+	matchStatus func(status metav1.Status) bool
+	// Status of the last matched error (if any).
+	actualStatus *metav1.Status
+}
+
+func (m *statusErrorMatcher) Match(actual interface{}) (bool, error) {
+	m.actualStatus = nil
+	if util.IsNil(actual) {
+		return false, errors.New("statusErrorMatcher expects an error but got nil")
+	}
+	err, ok := actual.(error)
+	if !ok {
+		return false, fmt.Errorf("statusErrorMatcher expects an error but got %T", actual)
+	}
+	var apiStatus apierrors.APIStatus
+	if !errors.As(err, &apiStatus) {
+		return false, nil
+	}
+	status := apiStatus.Status()
+	m.actualStatus = &status
+	return m.matchStatus(status), nil
+}
+
+func (m *statusErrorMatcher) failureMessageFormat(actual interface{}, base string) string {
+	if m.actualStatus == nil {
+		return fmt.Sprintf("%s %s\nActual (not an API status error):\n%s", base, m.expected, format.Object(actual, 1))
+	}
+	return fmt.Sprintf("%s %s\nActual status:\n%s", base, m.expected, format.Object(*m.actualStatus, 1))
+}
+
+func (m *statusErrorMatcher) FailureMessage(actual interface{}) string {
+	return m.failureMessageFormat(actual, "Expected API status error to have")
+}
+
+func (m *statusErrorMatcher) NegatedFailureMessage(actual interface{}) string {
+	return m.failureMessageFormat(actual, "Expected API status error not to have")
+}
+
+// NewStatusReasonMatcher creates a new statusErrorMatcher that checks
+// if errors have the expected status reason.
+func NewStatusReasonMatcher(reason metav1.StatusReason) types.GomegaMatcher {
+	return &statusErrorMatcher{
+		expected: fmt.Sprintf("reason %q", reason),
+		matchStatus: func(status metav1.Status) bool {
+			return status.Reason == reason
+		},
+	}
+}
+
+// NewStatusCodeMatcher creates a new statusErrorMatcher that checks
+// if errors have the expected HTTP status code.
+func NewStatusCodeMatcher(code int32) types.GomegaMatcher {
+	return &statusErrorMatcher{
+		expected: fmt.Sprintf("code %d", code),
+		matchStatus: func(status metav1.Status) bool {
+			return status.Code == code
+		},
+	}
+}
+
+// NewStatusCauseMatcher creates a new statusErrorMatcher that checks if errors have a
+// status cause matching the expected cause. Empty type and field values match any value,
+// and the expected message matches any actual message containing it.
+func NewStatusCauseMatcher(cause metav1.StatusCause) types.GomegaMatcher {
+	return &statusErrorMatcher{
+		expected: fmt.Sprintf("cause %s", format.Object(cause, 1)),
+		matchStatus: func(status metav1.Status) bool {
+			if status.Details == nil {
+				return false
+			}
+			for _, actual := range status.Details.Causes {
+				if (cause.Type == "" || actual.Type == cause.Type) &&
+					(cause.Field == "" || actual.Field == cause.Field) &&
+					strings.Contains(actual.Message, cause.Message) {
+					return true
+				}
+			}
+			return false
+		},
+	}
+}
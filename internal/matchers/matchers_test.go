@@ -1,10 +1,17 @@
 package matchers_test
 
 import (
+	"errors"
+	"fmt"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/eolatham/sawchain/internal/matchers"
@@ -242,6 +249,20 @@ metadata:
 				expectedInternalErr: "variable not defined: $missing",
 			}),
 		)
+
+		It("should reuse the rendered template across matches", func() {
+			matcher := matchers.NewChainsawMatcher(standardClient, `
+apiVersion: v1
+kind: ConfigMap
+data:
+  key1: ($value)
+`, map[string]any{"value": "value1"})
+			for i := 0; i < 3; i++ {
+				Expect(matcher.Match(testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "value1"}))).To(BeTrue())
+				Expect(matcher.Match(testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "value2"}))).To(BeFalse())
+			}
+			Expect(matcher.FailureMessage(nil)).To(ContainSubstring("data.key1: Invalid value: \"value2\": Expected value: \"value1\""))
+		})
 	})
 
 	Describe("NewStatusConditionMatcher", func() {
@@ -431,5 +452,154 @@ metadata:
 				expectedInternalErr: "failed to convert object to unstructured: no kind is registered for the type testutil.TestResource in scheme",
 			}),
 		)
+
+		It("should re-render the template when the object's kind changes", func() {
+			newResource := func(apiVersion, kind, status string) *unstructured.Unstructured {
+				obj := &unstructured.Unstructured{}
+				obj.SetAPIVersion(apiVersion)
+				obj.SetKind(kind)
+				obj.SetName("test")
+				Expect(unstructured.SetNestedSlice(obj.Object, []interface{}{
+					map[string]interface{}{"type": "Ready", "status": status},
+				}, "status", "conditions")).To(Succeed())
+				return obj
+			}
+			matcher := matchers.NewStatusConditionMatcher(standardClient, "Ready", "True")
+			Expect(matcher.Match(newResource("example.com/v1", "Widget", "True"))).To(BeTrue())
+			Expect(matcher.Match(newResource("example.com/v1", "Gadget", "True"))).To(BeTrue())
+			Expect(matcher.FailureMessage(nil)).To(ContainSubstring("kind: Gadget"))
+			Expect(matcher.Match(newResource("example.com/v1", "Gadget", "False"))).To(BeFalse())
+			Expect(matcher.Match(newResource("example.com/v1", "Widget", "True"))).To(BeTrue())
+			Expect(matcher.FailureMessage(nil)).To(ContainSubstring("kind: Widget"))
+		})
+	})
+
+	Describe("Status error matchers", func() {
+		type testCase struct {
+			matcher             types.GomegaMatcher
+			actual              interface{}
+			shouldMatch         bool
+			expectedInternalErr string
+			expectedFailureMsg  string
+		}
+
+		invalidErr := apierrors.NewInvalid(schema.GroupKind{Kind: "ConfigMap"}, "test-config", field.ErrorList{
+			field.Invalid(field.NewPath("data", "key"), "bad", "must be good"),
+			field.Required(field.NewPath("data", "other"), ""),
+		})
+		forbiddenErr := apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "test-config",
+			errors.New("denied by webhook"))
+
+		DescribeTable("matching errors against API status details",
+			func(tc testCase) {
+				// Test Match
+				match, err := tc.matcher.Match(tc.actual)
+				Expect(match).To(Equal(tc.shouldMatch))
+				if tc.expectedInternalErr != "" {
+					Expect(err).To(MatchError(ContainSubstring(tc.expectedInternalErr)))
+					return
+				}
+				Expect(err).NotTo(HaveOccurred())
+
+				// Test FailureMessage
+				failureMsg := tc.matcher.FailureMessage(tc.actual)
+				Expect(failureMsg).To(ContainSubstring("Expected API status error to have"))
+				Expect(failureMsg).To(ContainSubstring(tc.expectedFailureMsg))
+
+				// Test NegatedFailureMessage
+				negatedFailureMsg := tc.matcher.NegatedFailureMessage(tc.actual)
+				Expect(negatedFailureMsg).To(ContainSubstring("Expected API status error not to have"))
+				Expect(negatedFailureMsg).To(ContainSubstring(tc.expectedFailureMsg))
+			},
+
+			// Reason
+			Entry("reason match", testCase{
+				matcher:            matchers.NewStatusReasonMatcher(metav1.StatusReasonInvalid),
+				actual:             invalidErr,
+				shouldMatch:        true,
+				expectedFailureMsg: `reason "Invalid"`,
+			}),
+
+			Entry("reason mismatch", testCase{
+				matcher:            matchers.NewStatusReasonMatcher(metav1.StatusReasonInvalid),
+				actual:             forbiddenErr,
+				shouldMatch:        false,
+				expectedFailureMsg: "denied by webhook",
+			}),
+
+			Entry("reason match with wrapped error", testCase{
+				matcher:     matchers.NewStatusReasonMatcher(metav1.StatusReasonForbidden),
+				actual:      fmt.Errorf("failed to create: %w", forbiddenErr),
+				shouldMatch: true,
+			}),
+
+			// Code
+			Entry("code match", testCase{
+				matcher:            matchers.NewStatusCodeMatcher(422),
+				actual:             invalidErr,
+				shouldMatch:        true,
+				expectedFailureMsg: "code 422",
+			}),
+
+			Entry("code mismatch", testCase{
+				matcher:     matchers.NewStatusCodeMatcher(422),
+				actual:      forbiddenErr,
+				shouldMatch: false,
+			}),
+
+			// Cause
+			Entry("cause match by type, field, and message substring", testCase{
+				matcher: matchers.NewStatusCauseMatcher(metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   "data.key",
+					Message: "must be good",
+				}),
+				actual:      invalidErr,
+				shouldMatch: true,
+			}),
+
+			Entry("cause match by field only", testCase{
+				matcher:     matchers.NewStatusCauseMatcher(metav1.StatusCause{Field: "data.other"}),
+				actual:      invalidErr,
+				shouldMatch: true,
+			}),
+
+			Entry("cause mismatch", testCase{
+				matcher: matchers.NewStatusCauseMatcher(metav1.StatusCause{
+					Type:  metav1.CauseTypeFieldValueRequired,
+					Field: "data.key",
+				}),
+				actual:             invalidErr,
+				shouldMatch:        false,
+				expectedFailureMsg: "data.key",
+			}),
+
+			Entry("cause mismatch without details", testCase{
+				matcher:     matchers.NewStatusCauseMatcher(metav1.StatusCause{Field: "data.key"}),
+				actual:      forbiddenErr,
+				shouldMatch: false,
+			}),
+
+			// Non-status errors
+			Entry("no match on non-status error", testCase{
+				matcher:            matchers.NewStatusReasonMatcher(metav1.StatusReasonInvalid),
+				actual:             errors.New("plain error"),
+				shouldMatch:        false,
+				expectedFailureMsg: "not an API status error",
+			}),
+
+			// Error cases
+			Entry("error on nil input", testCase{
+				matcher:             matchers.NewStatusCodeMatcher(422),
+				actual:              nil,
+				expectedInternalErr: "statusErrorMatcher expects an error but got nil",
+			}),
+
+			Entry("error on non-error input", testCase{
+				matcher:             matchers.NewStatusCodeMatcher(422),
+				actual:              "not an error",
+				expectedInternalErr: "statusErrorMatcher expects an error but got string",
+			}),
+		)
 	})
 })
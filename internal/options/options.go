@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/eolatham/sawchain/internal/util"
@@ -16,14 +17,89 @@ const (
 	errObjectAndObjects = "client.Object and []client.Object arguments both provided"
 )
 
+// SkipCleanup is an argument type for disabling automatic cleanup of created resources.
+type SkipCleanup bool
+
+// RemoveFinalizers is an argument type for removing finalizers from resources stuck in deletion.
+type RemoveFinalizers bool
+
+// MergeOntoLive is an argument type for merging rendered templates onto the live state of resources.
+type MergeOntoLive bool
+
+// Subresource is an argument type for targeting a subresource (e.g. status or scale) of resources.
+type Subresource string
+
+// ObservedGenerationPath is an argument type for waiting until resources are reconciled, identified by the
+// dot-separated path of the field in which controllers record the last observed generation.
+type ObservedGenerationPath string
+
+// Concurrency is an argument type for limiting the number of API calls issued concurrently by bulk operations.
+type Concurrency int
+
+// MatchCount is an argument type for bounding the number of resources matching a template.
+type MatchCount struct {
+	Min       int  // Minimum number of matching resources.
+	Max       int  // Maximum number of matching resources (ignored if Unbounded).
+	Unbounded bool // Whether there is no maximum number of matching resources.
+}
+
+// String describes the bounds (e.g. "exactly 3" or "at least 1").
+func (m MatchCount) String() string {
+	switch {
+	case m.Unbounded:
+		return fmt.Sprintf("at least %d", m.Min)
+	case m.Min == m.Max:
+		return fmt.Sprintf("exactly %d", m.Min)
+	case m.Min == 0:
+		return fmt.Sprintf("at most %d", m.Max)
+	default:
+		return fmt.Sprintf("between %d and %d", m.Min, m.Max)
+	}
+}
+
+// Allows returns true if the given number of matching resources is within the bounds.
+func (m MatchCount) Allows(n int) bool {
+	return n >= m.Min && (m.Unbounded || n <= m.Max)
+}
+
+// Include is a set of flags for the option types accepted by an operation, in addition to the durations,
+// object(s), and template gated by parse arguments. Bindings are always accepted.
+type Include uint
+
+const (
+	IncludeClientOptions          Include = 1 << iota // Accept client options (e.g. client.FieldOwner).
+	IncludePatchType                                  // Accept a PatchType.
+	IncludeSkipCleanup                                // Accept SkipCleanup.
+	IncludeRemoveFinalizers                           // Accept RemoveFinalizers.
+	IncludeMergeOntoLive                              // Accept MergeOntoLive.
+	IncludeSubresource                                // Accept a Subresource.
+	IncludeObservedGenerationPath                     // Accept an ObservedGenerationPath.
+	IncludeConcurrency                                // Accept a Concurrency.
+	IncludeMatchCount                                 // Accept a MatchCount.
+	IncludeMutation                                   // Accept a mutation function.
+	IncludeObjectList                                 // Accept a client.ObjectList.
+)
+
 // Options is a common struct for options used in Sawchain operations.
 type Options struct {
-	Timeout  time.Duration   // Timeout for eventual assertions.
-	Interval time.Duration   // Polling interval for eventual assertions.
-	Template string          // Template content for Chainsaw resource operations.
-	Bindings map[string]any  // Template bindings for Chainsaw resource operations.
-	Object   client.Object   // Object to store state for single-resource operations.
-	Objects  []client.Object // Slice to store state for multi-resource operations.
+	Timeout          time.Duration             // Timeout for eventual assertions.
+	Interval         time.Duration             // Polling interval for eventual assertions.
+	Template         string                    // Template content for Chainsaw resource operations.
+	Bindings         map[string]any            // Template bindings for Chainsaw resource operations.
+	Object           client.Object             // Object to store state for single-resource operations.
+	Objects          []client.Object           // Slice to store state for multi-resource operations.
+	ClientOptions    []interface{}             // Client options (e.g. client.FieldOwner) for K8s API operations.
+	PatchType        types.PatchType           // Patch type for patch operations.
+	SkipCleanup      bool                      // Whether to skip automatic cleanup of created resources.
+	Subresource      string                    // Subresource to target instead of the main resource.
+	RemoveFinalizers bool                      // Whether to remove finalizers from resources not deleted within the timeout.
+	Mutation         func(client.Object) error // Mutation function for mutate operations.
+	MergeOntoLive    bool                      // Whether to merge rendered templates onto the live state of resources.
+	ObjectList       client.ObjectList         // List to store state for list operations.
+	MatchCount       *MatchCount               // Bounds on the number of resources matching a template.
+
+	ObservedGenerationPath string // Path of the observed generation field to wait for after writes.
+	Concurrency            int    // Maximum number of concurrent API calls for bulk operations.
 }
 
 // parse parses variable arguments into an Options struct.
@@ -31,11 +107,13 @@ type Options struct {
 //   - If includeObject is true, checks for Object; otherwise disallows it.
 //   - If includeObjects is true, checks for Objects; otherwise disallows it.
 //   - If includeTemplate is true, checks for Template; otherwise disallows it.
+//   - Checks for each other option type included in include; disallows the rest.
 func parse(
 	includeDurations bool,
 	includeObject bool,
 	includeObjects bool,
 	includeTemplate bool,
+	include Include,
 	args ...interface{},
 ) (*Options, error) {
 	opts := &Options{
@@ -111,6 +189,127 @@ func parse(
 			}
 		}
 
+		if include&IncludeClientOptions != 0 {
+			// Check for ClientOptions
+			if clientOpt, ok := util.AsClientOption(arg); ok {
+				opts.ClientOptions = append(opts.ClientOptions, clientOpt)
+				continue
+			}
+		}
+
+		if include&IncludePatchType != 0 {
+			// Check for PatchType
+			if patchType, ok := arg.(types.PatchType); ok {
+				if opts.PatchType != "" {
+					return nil, errors.New("multiple patch type arguments provided")
+				}
+				opts.PatchType = patchType
+				continue
+			}
+		}
+
+		if include&IncludeSkipCleanup != 0 {
+			// Check for SkipCleanup
+			if skip, ok := arg.(SkipCleanup); ok {
+				opts.SkipCleanup = bool(skip)
+				continue
+			}
+		}
+
+		if include&IncludeRemoveFinalizers != 0 {
+			// Check for RemoveFinalizers
+			if remove, ok := arg.(RemoveFinalizers); ok {
+				opts.RemoveFinalizers = bool(remove)
+				continue
+			}
+		}
+
+		if include&IncludeMergeOntoLive != 0 {
+			// Check for MergeOntoLive
+			if merge, ok := arg.(MergeOntoLive); ok {
+				opts.MergeOntoLive = bool(merge)
+				continue
+			}
+		}
+
+		if include&IncludeSubresource != 0 {
+			// Check for Subresource
+			if subresource, ok := arg.(Subresource); ok {
+				if opts.Subresource != "" {
+					return nil, errors.New("multiple subresource arguments provided")
+				}
+				opts.Subresource = string(subresource)
+				continue
+			}
+		}
+
+		if include&IncludeObservedGenerationPath != 0 {
+			// Check for ObservedGenerationPath
+			if path, ok := arg.(ObservedGenerationPath); ok {
+				if opts.ObservedGenerationPath != "" {
+					return nil, errors.New("multiple observed generation path arguments provided")
+				} else if path == "" {
+					return nil, errors.New("provided observed generation path is empty")
+				}
+				opts.ObservedGenerationPath = string(path)
+				continue
+			}
+		}
+
+		if include&IncludeConcurrency != 0 {
+			// Check for Concurrency
+			if concurrency, ok := arg.(Concurrency); ok {
+				if opts.Concurrency != 0 {
+					return nil, errors.New("multiple concurrency arguments provided")
+				} else if concurrency < 1 {
+					return nil, errors.New("provided concurrency must be positive")
+				}
+				opts.Concurrency = int(concurrency)
+				continue
+			}
+		}
+
+		if include&IncludeMatchCount != 0 {
+			// Check for MatchCount
+			if count, ok := arg.(MatchCount); ok {
+				if opts.MatchCount != nil {
+					return nil, errors.New("multiple match count arguments provided")
+				} else if count.Min < 0 {
+					return nil, errors.New("provided match count minimum must not be negative")
+				} else if !count.Unbounded && count.Max < 0 {
+					return nil, errors.New("provided match count maximum must not be negative")
+				} else if !count.Unbounded && count.Max < count.Min {
+					return nil, errors.New("provided match count maximum must not be less than minimum")
+				}
+				opts.MatchCount = &count
+				continue
+			}
+		}
+
+		if include&IncludeMutation != 0 {
+			// Check for Mutation
+			if mutation, ok := util.AsMutation(arg); ok {
+				if opts.Mutation != nil {
+					return nil, errors.New("multiple mutation function arguments provided")
+				}
+				opts.Mutation = mutation
+				continue
+			}
+		}
+
+		if include&IncludeObjectList != 0 {
+			// Check for ObjectList
+			if list, ok := util.AsObjectList(arg); ok {
+				if opts.ObjectList != nil {
+					return nil, errors.New("multiple client.ObjectList arguments provided")
+				} else if util.IsNil(list) {
+					return nil, errors.New("provided client.ObjectList is nil or has a nil underlying value")
+				}
+				opts.ObjectList = list
+				continue
+			}
+		}
+
 		// Check for Bindings
 		if bindings, ok := util.AsMapStringAny(arg); ok {
 			opts.Bindings = util.MergeMaps(opts.Bindings, bindings)
@@ -148,6 +347,17 @@ func requireTemplate(opts *Options) error {
 	return nil
 }
 
+// requireObject requires option Object to be provided.
+func requireObject(opts *Options) error {
+	if opts == nil {
+		return errors.New(errNil)
+	}
+	if opts.Object == nil {
+		return errors.New(errRequired + ": Object (client.Object)")
+	}
+	return nil
+}
+
 // requireTemplateObject requires options Template or Object to be provided.
 func requireTemplateObject(opts *Options) error {
 	if opts == nil {
@@ -181,6 +391,28 @@ func requireTemplateObjectObjects(opts *Options) error {
 	return nil
 }
 
+// requireTemplateObjectList requires options Template or ObjectList to be provided.
+func requireTemplateObjectList(opts *Options) error {
+	if opts == nil {
+		return errors.New(errNil)
+	}
+	if len(opts.Template) == 0 && opts.ObjectList == nil {
+		return errors.New(errRequired + ": Template (string) or ObjectList (client.ObjectList)")
+	}
+	return nil
+}
+
+// requireTemplateMutation requires options Template or Mutation to be provided.
+func requireTemplateMutation(opts *Options) error {
+	if opts == nil {
+		return errors.New(errNil)
+	}
+	if len(opts.Template) == 0 && opts.Mutation == nil {
+		return errors.New(errRequired + ": Template (string) or Mutation (func(client.Object))")
+	}
+	return nil
+}
+
 // applyDefaults applies defaults to the given options where needed.
 func applyDefaults(defaults, opts *Options) *Options {
 	// Nil checks
@@ -201,6 +433,21 @@ func applyDefaults(defaults, opts *Options) *Options {
 	// Merge bindings
 	opts.Bindings = util.MergeMaps(defaults.Bindings, opts.Bindings)
 
+	// Default observed generation path
+	if opts.ObservedGenerationPath == "" {
+		opts.ObservedGenerationPath = defaults.ObservedGenerationPath
+	}
+
+	// Default concurrency
+	if opts.Concurrency == 0 {
+		opts.Concurrency = defaults.Concurrency
+	}
+
+	// Inherit flags
+	opts.SkipCleanup = opts.SkipCleanup || defaults.SkipCleanup
+	opts.RemoveFinalizers = opts.RemoveFinalizers || defaults.RemoveFinalizers
+	opts.MergeOntoLive = opts.MergeOntoLive || defaults.MergeOntoLive
+
 	return opts
 }
 
@@ -212,9 +459,10 @@ func parseAndApplyDefaults(
 	includeObject bool,
 	includeObjects bool,
 	includeTemplate bool,
+	include Include,
 	args ...interface{},
 ) (*Options, error) {
-	opts, err := parse(includeDurations, includeObject, includeObjects, includeTemplate, args...)
+	opts, err := parse(includeDurations, includeObject, includeObjects, includeTemplate, include, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -222,8 +470,8 @@ func parseAndApplyDefaults(
 }
 
 // ParseAndRequireGlobal parses and requires options for the Sawchain constructor.
-func ParseAndRequireGlobal(defaults *Options, args ...interface{}) (*Options, error) {
-	opts, err := parseAndApplyDefaults(defaults, true, false, false, false, args...)
+func ParseAndRequireGlobal(defaults *Options, include Include, args ...interface{}) (*Options, error) {
+	opts, err := parseAndApplyDefaults(defaults, true, false, false, false, include, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -234,8 +482,8 @@ func ParseAndRequireGlobal(defaults *Options, args ...interface{}) (*Options, er
 }
 
 // ParseAndRequireEventual parses and requires options for Sawchain eventual operations.
-func ParseAndRequireEventual(defaults *Options, args ...interface{}) (*Options, error) {
-	opts, err := parseAndApplyDefaults(defaults, true, true, true, true, args...)
+func ParseAndRequireEventual(defaults *Options, include Include, args ...interface{}) (*Options, error) {
+	opts, err := parseAndApplyDefaults(defaults, true, true, true, true, include, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -248,9 +496,63 @@ func ParseAndRequireEventual(defaults *Options, args ...interface{}) (*Options,
 	return opts, nil
 }
 
+// ParseAndRequireEventualTemplate parses and requires options
+// for Sawchain eventual template operations.
+func ParseAndRequireEventualTemplate(defaults *Options, include Include, args ...interface{}) (*Options, error) {
+	opts, err := parseAndApplyDefaults(defaults, true, true, true, true, include, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireDurations(opts); err != nil {
+		return nil, err
+	}
+	if err := requireTemplate(opts); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// ParseAndRequireEventualMutation parses and requires options
+// for Sawchain eventual mutation operations.
+func ParseAndRequireEventualMutation(defaults *Options, include Include, args ...interface{}) (*Options, error) {
+	opts, err := parseAndApplyDefaults(defaults, true, true, false, true, include|IncludeMutation, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireDurations(opts); err != nil {
+		return nil, err
+	}
+	if err := requireTemplateObject(opts); err != nil {
+		return nil, err
+	}
+	if err := requireTemplateMutation(opts); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// ParseAndRequireEventualObjectTemplate parses and requires options
+// for Sawchain eventual operations on an object with a template.
+func ParseAndRequireEventualObjectTemplate(defaults *Options, include Include, args ...interface{}) (*Options, error) {
+	opts, err := parseAndApplyDefaults(defaults, true, true, false, true, include, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireDurations(opts); err != nil {
+		return nil, err
+	}
+	if err := requireObject(opts); err != nil {
+		return nil, err
+	}
+	if err := requireTemplate(opts); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
 // ParseAndRequireImmediate parses and requires options for Sawchain immediate operations.
-func ParseAndRequireImmediate(defaults *Options, args ...interface{}) (*Options, error) {
-	opts, err := parseAndApplyDefaults(defaults, false, true, true, true, args...)
+func ParseAndRequireImmediate(defaults *Options, include Include, args ...interface{}) (*Options, error) {
+	opts, err := parseAndApplyDefaults(defaults, false, true, true, true, include, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -262,8 +564,8 @@ func ParseAndRequireImmediate(defaults *Options, args ...interface{}) (*Options,
 
 // ParseAndRequireImmediateSingle parses and requires options
 // for Sawchain immediate single-resource operations.
-func ParseAndRequireImmediateSingle(defaults *Options, args ...interface{}) (*Options, error) {
-	opts, err := parseAndApplyDefaults(defaults, false, true, false, true, args...)
+func ParseAndRequireImmediateSingle(defaults *Options, include Include, args ...interface{}) (*Options, error) {
+	opts, err := parseAndApplyDefaults(defaults, false, true, false, true, include, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -275,8 +577,8 @@ func ParseAndRequireImmediateSingle(defaults *Options, args ...interface{}) (*Op
 
 // ParseAndRequireImmediateMulti parses and requires options
 // for Sawchain immediate multi-resource operations.
-func ParseAndRequireImmediateMulti(defaults *Options, args ...interface{}) (*Options, error) {
-	opts, err := parseAndApplyDefaults(defaults, false, false, true, true, args...)
+func ParseAndRequireImmediateMulti(defaults *Options, include Include, args ...interface{}) (*Options, error) {
+	opts, err := parseAndApplyDefaults(defaults, false, false, true, true, include, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -288,8 +590,34 @@ func ParseAndRequireImmediateMulti(defaults *Options, args ...interface{}) (*Opt
 
 // ParseAndRequireImmediateTemplate parses and requires options
 // for Sawchain immediate template operations.
-func ParseAndRequireImmediateTemplate(defaults *Options, args ...interface{}) (*Options, error) {
-	opts, err := parseAndApplyDefaults(defaults, false, true, true, true, args...)
+func ParseAndRequireImmediateTemplate(defaults *Options, include Include, args ...interface{}) (*Options, error) {
+	opts, err := parseAndApplyDefaults(defaults, false, true, true, true, include, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireTemplate(opts); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// ParseAndRequireImmediateList parses and requires options
+// for Sawchain immediate list operations.
+func ParseAndRequireImmediateList(defaults *Options, include Include, args ...interface{}) (*Options, error) {
+	opts, err := parseAndApplyDefaults(defaults, false, false, false, true, include|IncludeObjectList, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireTemplateObjectList(opts); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// ParseAndRequireImmediateTemplateOnly parses and requires options
+// for Sawchain immediate template operations that don't save state.
+func ParseAndRequireImmediateTemplateOnly(defaults *Options, include Include, args ...interface{}) (*Options, error) {
+	opts, err := parseAndApplyDefaults(defaults, false, false, false, true, include, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -7,6 +7,8 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/eolatham/sawchain/internal/options"
@@ -17,6 +19,7 @@ var _ = Describe("Options", func() {
 	Describe("ParseAndRequireGlobal", func() {
 		type testCase struct {
 			defaults      *options.Options
+			include       options.Include
 			args          []interface{}
 			expected      *options.Options
 			expectedError string
@@ -24,7 +27,7 @@ var _ = Describe("Options", func() {
 
 		DescribeTable("parsing and requiring global options",
 			func(tc testCase) {
-				result, err := options.ParseAndRequireGlobal(tc.defaults, tc.args...)
+				result, err := options.ParseAndRequireGlobal(tc.defaults, tc.include, tc.args...)
 				if tc.expectedError != "" {
 					Expect(err).To(HaveOccurred())
 					Expect(err.Error()).To(ContainSubstring(tc.expectedError))
@@ -65,6 +68,30 @@ var _ = Describe("Options", func() {
 				},
 			}),
 
+			Entry("valid timeout and interval with skip cleanup", testCase{
+				defaults: nil,
+				include:  options.IncludeSkipCleanup,
+				args:     []interface{}{"5s", "1s", options.SkipCleanup(true)},
+				expected: &options.Options{
+					Timeout:     5 * time.Second,
+					Interval:    1 * time.Second,
+					Bindings:    map[string]any{},
+					SkipCleanup: true,
+				},
+			}),
+
+			Entry("valid timeout and interval with remove finalizers", testCase{
+				defaults: nil,
+				include:  options.IncludeRemoveFinalizers,
+				args:     []interface{}{"5s", "1s", options.RemoveFinalizers(true)},
+				expected: &options.Options{
+					Timeout:          5 * time.Second,
+					Interval:         1 * time.Second,
+					Bindings:         map[string]any{},
+					RemoveFinalizers: true,
+				},
+			}),
+
 			// Using defaults
 			Entry("use defaults when no args provided", testCase{
 				defaults: &options.Options{
@@ -80,6 +107,68 @@ var _ = Describe("Options", func() {
 				},
 			}),
 
+			Entry("inherit default observed generation path", testCase{
+				defaults: &options.Options{
+					Timeout:                10 * time.Second,
+					Interval:               2 * time.Second,
+					ObservedGenerationPath: "status.observedGeneration",
+				},
+				args: []interface{}{},
+				expected: &options.Options{
+					Timeout:                10 * time.Second,
+					Interval:               2 * time.Second,
+					Bindings:               map[string]any{},
+					ObservedGenerationPath: "status.observedGeneration",
+				},
+			}),
+
+			Entry("override default observed generation path", testCase{
+				defaults: &options.Options{
+					Timeout:                10 * time.Second,
+					Interval:               2 * time.Second,
+					ObservedGenerationPath: "status.observedGeneration",
+				},
+				include: options.IncludeObservedGenerationPath,
+				args:    []interface{}{options.ObservedGenerationPath("status.custom.observedGeneration")},
+				expected: &options.Options{
+					Timeout:                10 * time.Second,
+					Interval:               2 * time.Second,
+					Bindings:               map[string]any{},
+					ObservedGenerationPath: "status.custom.observedGeneration",
+				},
+			}),
+
+			Entry("inherit default concurrency", testCase{
+				defaults: &options.Options{
+					Timeout:     10 * time.Second,
+					Interval:    2 * time.Second,
+					Concurrency: 8,
+				},
+				args: []interface{}{},
+				expected: &options.Options{
+					Timeout:     10 * time.Second,
+					Interval:    2 * time.Second,
+					Bindings:    map[string]any{},
+					Concurrency: 8,
+				},
+			}),
+
+			Entry("override default concurrency", testCase{
+				defaults: &options.Options{
+					Timeout:     10 * time.Second,
+					Interval:    2 * time.Second,
+					Concurrency: 8,
+				},
+				include: options.IncludeConcurrency,
+				args:    []interface{}{options.Concurrency(2)},
+				expected: &options.Options{
+					Timeout:     10 * time.Second,
+					Interval:    2 * time.Second,
+					Bindings:    map[string]any{},
+					Concurrency: 2,
+				},
+			}),
+
 			Entry("override default timeout only", testCase{
 				defaults: &options.Options{
 					Timeout:  10 * time.Second,
@@ -240,6 +329,7 @@ var _ = Describe("Options", func() {
 	Describe("ParseAndRequireEventual", func() {
 		type testCase struct {
 			defaults      *options.Options
+			include       options.Include
 			args          []interface{}
 			expected      *options.Options
 			expectedError string
@@ -247,7 +337,7 @@ var _ = Describe("Options", func() {
 
 		DescribeTable("parsing and requiring eventual operation options",
 			func(tc testCase) {
-				result, err := options.ParseAndRequireEventual(tc.defaults, tc.args...)
+				result, err := options.ParseAndRequireEventual(tc.defaults, tc.include, tc.args...)
 				if tc.expectedError != "" {
 					Expect(err).To(HaveOccurred())
 					Expect(err.Error()).To(ContainSubstring(tc.expectedError))
@@ -407,6 +497,89 @@ var _ = Describe("Options", func() {
 				},
 			}),
 
+			// Valid arguments - flags
+			Entry("valid durations, template, and skip cleanup", testCase{
+				defaults: nil,
+				include:  options.IncludeSkipCleanup,
+				args:     []interface{}{"5s", "1s", "template content", options.SkipCleanup(true)},
+				expected: &options.Options{
+					Timeout:     5 * time.Second,
+					Interval:    1 * time.Second,
+					Template:    "template content",
+					Bindings:    map[string]any{},
+					SkipCleanup: true,
+				},
+			}),
+
+			Entry("valid durations, template, and merge onto live", testCase{
+				defaults: nil,
+				include:  options.IncludeMergeOntoLive,
+				args:     []interface{}{"5s", "1s", "template content", options.MergeOntoLive(true)},
+				expected: &options.Options{
+					Timeout:       5 * time.Second,
+					Interval:      1 * time.Second,
+					Template:      "template content",
+					Bindings:      map[string]any{},
+					MergeOntoLive: true,
+				},
+			}),
+
+			Entry("valid durations, template, and client options", testCase{
+				defaults: nil,
+				include:  options.IncludeClientOptions,
+				args:     []interface{}{"5s", "1s", "template content", client.FieldOwner("test"), client.ForceOwnership},
+				expected: &options.Options{
+					Timeout:       5 * time.Second,
+					Interval:      1 * time.Second,
+					Template:      "template content",
+					Bindings:      map[string]any{},
+					ClientOptions: []interface{}{client.FieldOwner("test"), client.ForceOwnership},
+				},
+			}),
+
+			Entry("client options not mistaken for bindings", testCase{
+				defaults: nil,
+				include:  options.IncludeClientOptions,
+				args:     []interface{}{"5s", "1s", "template content", client.MatchingLabels{"app": "test"}},
+				expected: &options.Options{
+					Timeout:       5 * time.Second,
+					Interval:      1 * time.Second,
+					Template:      "template content",
+					Bindings:      map[string]any{},
+					ClientOptions: []interface{}{client.MatchingLabels{"app": "test"}},
+				},
+			}),
+
+			Entry("valid durations, template, and patch type", testCase{
+				defaults: nil,
+				include:  options.IncludePatchType,
+				args:     []interface{}{"5s", "1s", "template content", types.JSONPatchType},
+				expected: &options.Options{
+					Timeout:   5 * time.Second,
+					Interval:  1 * time.Second,
+					Template:  "template content",
+					Bindings:  map[string]any{},
+					PatchType: types.JSONPatchType,
+				},
+			}),
+
+			Entry("valid durations, object, and subresource", testCase{
+				defaults: nil,
+				include:  options.IncludeSubresource,
+				args: []interface{}{
+					"5s", "1s",
+					testutil.NewConfigMap("test-config", "default", nil),
+					options.Subresource("status"),
+				},
+				expected: &options.Options{
+					Timeout:     5 * time.Second,
+					Interval:    1 * time.Second,
+					Bindings:    map[string]any{},
+					Object:      testutil.NewConfigMap("test-config", "default", nil),
+					Subresource: "status",
+				},
+			}),
+
 			// Using defaults
 			Entry("use defaults for durations", testCase{
 				defaults: &options.Options{
@@ -423,6 +596,57 @@ var _ = Describe("Options", func() {
 				},
 			}),
 
+			Entry("inherit default skip cleanup", testCase{
+				defaults: &options.Options{
+					Timeout:     10 * time.Second,
+					Interval:    2 * time.Second,
+					Bindings:    map[string]any{"default": "value"},
+					SkipCleanup: true,
+				},
+				args: []interface{}{"template content"},
+				expected: &options.Options{
+					Timeout:     10 * time.Second,
+					Interval:    2 * time.Second,
+					Template:    "template content",
+					Bindings:    map[string]any{"default": "value"},
+					SkipCleanup: true,
+				},
+			}),
+
+			Entry("inherit default remove finalizers", testCase{
+				defaults: &options.Options{
+					Timeout:          10 * time.Second,
+					Interval:         2 * time.Second,
+					RemoveFinalizers: true,
+				},
+				include: options.IncludeClientOptions,
+				args:    []interface{}{"template content", client.PropagationPolicy(metav1.DeletePropagationForeground)},
+				expected: &options.Options{
+					Timeout:          10 * time.Second,
+					Interval:         2 * time.Second,
+					Template:         "template content",
+					Bindings:         map[string]any{},
+					ClientOptions:    []interface{}{client.PropagationPolicy(metav1.DeletePropagationForeground)},
+					RemoveFinalizers: true,
+				},
+			}),
+
+			Entry("inherit default merge onto live", testCase{
+				defaults: &options.Options{
+					Timeout:       10 * time.Second,
+					Interval:      2 * time.Second,
+					MergeOntoLive: true,
+				},
+				args: []interface{}{"template content"},
+				expected: &options.Options{
+					Timeout:       10 * time.Second,
+					Interval:      2 * time.Second,
+					Template:      "template content",
+					Bindings:      map[string]any{},
+					MergeOntoLive: true,
+				},
+			}),
+
 			Entry("override default timeout only", testCase{
 				defaults: &options.Options{
 					Timeout:  10 * time.Second,
@@ -540,6 +764,100 @@ var _ = Describe("Options", func() {
 				expectedError: "too many duration arguments provided",
 			}),
 
+			Entry("client options not included", testCase{
+				defaults:      nil,
+				args:          []interface{}{"5s", "1s", "template content", client.DryRunAll},
+				expectedError: "unexpected argument type: client.dryRunAll",
+			}),
+
+			Entry("subresource not included", testCase{
+				defaults:      nil,
+				include:       options.IncludeClientOptions,
+				args:          []interface{}{"5s", "1s", "template content", options.Subresource("status")},
+				expectedError: "unexpected argument type: options.Subresource",
+			}),
+
+			Entry("match count not included", testCase{
+				defaults:      nil,
+				include:       options.IncludeClientOptions | options.IncludeSkipCleanup,
+				args:          []interface{}{"5s", "1s", "template content", options.MatchCount{Min: 3, Max: 3}},
+				expectedError: "unexpected argument type: options.MatchCount",
+			}),
+
+			Entry("multiple patch type arguments", testCase{
+				defaults:      nil,
+				include:       options.IncludePatchType,
+				args:          []interface{}{"5s", "1s", "template content", types.MergePatchType, types.JSONPatchType},
+				expectedError: "multiple patch type arguments provided",
+			}),
+
+			Entry("multiple subresource arguments", testCase{
+				defaults:      nil,
+				include:       options.IncludeSubresource,
+				args:          []interface{}{"5s", "1s", "template content", options.Subresource("status"), options.Subresource("scale")},
+				expectedError: "multiple subresource arguments provided",
+			}),
+
+			Entry("multiple observed generation path arguments", testCase{
+				defaults: nil,
+				include:  options.IncludeObservedGenerationPath,
+				args: []interface{}{
+					"5s", "1s", "template content",
+					options.ObservedGenerationPath("status.observedGeneration"),
+					options.ObservedGenerationPath("status.custom.observedGeneration"),
+				},
+				expectedError: "multiple observed generation path arguments provided",
+			}),
+
+			Entry("empty observed generation path", testCase{
+				defaults:      nil,
+				include:       options.IncludeObservedGenerationPath,
+				args:          []interface{}{"5s", "1s", "template content", options.ObservedGenerationPath("")},
+				expectedError: "provided observed generation path is empty",
+			}),
+
+			Entry("multiple concurrency arguments", testCase{
+				defaults:      nil,
+				include:       options.IncludeConcurrency,
+				args:          []interface{}{"5s", "1s", "template content", options.Concurrency(2), options.Concurrency(4)},
+				expectedError: "multiple concurrency arguments provided",
+			}),
+
+			Entry("non-positive concurrency", testCase{
+				defaults:      nil,
+				include:       options.IncludeConcurrency,
+				args:          []interface{}{"5s", "1s", "template content", options.Concurrency(0)},
+				expectedError: "provided concurrency must be positive",
+			}),
+
+			Entry("multiple match count arguments", testCase{
+				defaults:      nil,
+				include:       options.IncludeMatchCount,
+				args:          []interface{}{"5s", "1s", "template content", options.MatchCount{Min: 1, Max: 1}, options.MatchCount{Min: 0, Max: 2}},
+				expectedError: "multiple match count arguments provided",
+			}),
+
+			Entry("negative match count minimum", testCase{
+				defaults:      nil,
+				include:       options.IncludeMatchCount,
+				args:          []interface{}{"5s", "1s", "template content", options.MatchCount{Min: -1, Max: 1}},
+				expectedError: "provided match count minimum must not be negative",
+			}),
+
+			Entry("negative match count maximum", testCase{
+				defaults:      nil,
+				include:       options.IncludeMatchCount,
+				args:          []interface{}{"5s", "1s", "template content", options.MatchCount{Min: 0, Max: -1}},
+				expectedError: "provided match count maximum must not be negative",
+			}),
+
+			Entry("match count maximum less than minimum", testCase{
+				defaults:      nil,
+				include:       options.IncludeMatchCount,
+				args:          []interface{}{"5s", "1s", "template content", options.MatchCount{Min: 2, Max: 1}},
+				expectedError: "provided match count maximum must not be less than minimum",
+			}),
+
 			Entry("multiple template arguments", testCase{
 				defaults:      nil,
 				args:          []interface{}{"5s", "1s", "template1", "template2"},
@@ -617,9 +935,165 @@ var _ = Describe("Options", func() {
 		)
 	})
 
+	Describe("ParseAndRequireEventualTemplate", func() {
+		type testCase struct {
+			defaults      *options.Options
+			include       options.Include
+			args          []interface{}
+			expected      *options.Options
+			expectedError string
+		}
+
+		DescribeTable("parsing and requiring eventual template operation options",
+			func(tc testCase) {
+				result, err := options.ParseAndRequireEventualTemplate(tc.defaults, tc.include, tc.args...)
+				if tc.expectedError != "" {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(tc.expected))
+				}
+			},
+
+			// Valid arguments
+			Entry("valid template with durations", testCase{
+				defaults: nil,
+				args:     []interface{}{"5s", "1s", "template content"},
+				expected: &options.Options{
+					Timeout:  5 * time.Second,
+					Interval: time.Second,
+					Template: "template content",
+					Bindings: map[string]any{},
+				},
+			}),
+
+			Entry("valid template, object, and patch type with default durations", testCase{
+				defaults: &options.Options{
+					Timeout:  10 * time.Second,
+					Interval: 2 * time.Second,
+				},
+				include: options.IncludePatchType,
+				args: []interface{}{
+					testutil.NewConfigMap("test-config", "default", nil),
+					types.JSONPatchType,
+					"template content",
+				},
+				expected: &options.Options{
+					Timeout:   10 * time.Second,
+					Interval:  2 * time.Second,
+					Template:  "template content",
+					Bindings:  map[string]any{},
+					Object:    testutil.NewConfigMap("test-config", "default", nil),
+					PatchType: types.JSONPatchType,
+				},
+			}),
+
+			// Invalid arguments
+			Entry("missing template", testCase{
+				defaults:      nil,
+				args:          []interface{}{"5s", "1s", testutil.NewConfigMap("test-config", "default", nil)},
+				expectedError: "required argument(s) not provided: Template (string)",
+			}),
+
+			Entry("missing durations", testCase{
+				defaults:      nil,
+				args:          []interface{}{"template content"},
+				expectedError: "required argument(s) not provided: Timeout (string or time.Duration)",
+			}),
+		)
+	})
+
+	Describe("ParseAndRequireEventualMutation", func() {
+		type testCase struct {
+			defaults         *options.Options
+			include          options.Include
+			args             []interface{}
+			expected         *options.Options
+			expectedMutation bool
+			expectedError    string
+		}
+
+		DescribeTable("parsing and requiring eventual mutation operation options",
+			func(tc testCase) {
+				result, err := options.ParseAndRequireEventualMutation(tc.defaults, tc.include, tc.args...)
+				if tc.expectedError != "" {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+					// Functions can't be compared for equality
+					Expect(result.Mutation != nil).To(Equal(tc.expectedMutation))
+					result.Mutation = nil
+					Expect(result).To(Equal(tc.expected))
+				}
+			},
+
+			// Valid arguments
+			Entry("valid object and mutation", testCase{
+				defaults: nil,
+				args: []interface{}{
+					"5s", "1s",
+					testutil.NewConfigMap("test-config", "default", nil),
+					func(*corev1.ConfigMap) {},
+				},
+				expected: &options.Options{
+					Timeout:  5 * time.Second,
+					Interval: time.Second,
+					Bindings: map[string]any{},
+					Object:   testutil.NewConfigMap("test-config", "default", nil),
+				},
+				expectedMutation: true,
+			}),
+
+			Entry("valid template with default durations", testCase{
+				defaults: &options.Options{
+					Timeout:  10 * time.Second,
+					Interval: 2 * time.Second,
+				},
+				args: []interface{}{"template content"},
+				expected: &options.Options{
+					Timeout:  10 * time.Second,
+					Interval: 2 * time.Second,
+					Template: "template content",
+					Bindings: map[string]any{},
+				},
+			}),
+
+			// Invalid arguments
+			Entry("missing template and mutation", testCase{
+				defaults:      nil,
+				args:          []interface{}{"5s", "1s", testutil.NewConfigMap("test-config", "default", nil)},
+				expectedError: "required argument(s) not provided: Template (string) or Mutation (func(client.Object))",
+			}),
+
+			Entry("missing template and object", testCase{
+				defaults:      nil,
+				args:          []interface{}{"5s", "1s", func(client.Object) {}},
+				expectedError: "required argument(s) not provided: Template (string) or Object (client.Object)",
+			}),
+
+			Entry("multiple mutation arguments", testCase{
+				defaults:      nil,
+				args:          []interface{}{"5s", "1s", "template content", func(client.Object) {}, func(client.Object) {}},
+				expectedError: "multiple mutation function arguments provided",
+			}),
+
+			Entry("disallowed objects argument", testCase{
+				defaults: nil,
+				args: []interface{}{
+					"5s", "1s", "template content",
+					[]client.Object{testutil.NewConfigMap("test-config", "default", nil)},
+				},
+				expectedError: "unexpected argument type: []client.Object",
+			}),
+		)
+	})
+
 	Describe("ParseAndRequireImmediate", func() {
 		type testCase struct {
 			defaults      *options.Options
+			include       options.Include
 			args          []interface{}
 			expected      *options.Options
 			expectedError string
@@ -627,7 +1101,7 @@ var _ = Describe("Options", func() {
 
 		DescribeTable("parsing and requiring immediate operation options",
 			func(tc testCase) {
-				result, err := options.ParseAndRequireImmediate(tc.defaults, tc.args...)
+				result, err := options.ParseAndRequireImmediate(tc.defaults, tc.include, tc.args...)
 				if tc.expectedError != "" {
 					Expect(err).To(HaveOccurred())
 					Expect(err.Error()).To(ContainSubstring(tc.expectedError))
@@ -901,6 +1375,7 @@ var _ = Describe("Options", func() {
 	Describe("ParseAndRequireImmediateSingle", func() {
 		type testCase struct {
 			defaults      *options.Options
+			include       options.Include
 			args          []interface{}
 			expected      *options.Options
 			expectedError string
@@ -908,7 +1383,7 @@ var _ = Describe("Options", func() {
 
 		DescribeTable("parsing and requiring immediate single-resource operation options",
 			func(tc testCase) {
-				result, err := options.ParseAndRequireImmediateSingle(tc.defaults, tc.args...)
+				result, err := options.ParseAndRequireImmediateSingle(tc.defaults, tc.include, tc.args...)
 				if tc.expectedError != "" {
 					Expect(err).To(HaveOccurred())
 					Expect(err.Error()).To(ContainSubstring(tc.expectedError))
@@ -1130,6 +1605,7 @@ var _ = Describe("Options", func() {
 	Describe("ParseAndRequireImmediateMulti", func() {
 		type testCase struct {
 			defaults      *options.Options
+			include       options.Include
 			args          []interface{}
 			expected      *options.Options
 			expectedError string
@@ -1137,7 +1613,7 @@ var _ = Describe("Options", func() {
 
 		DescribeTable("parsing and requiring immediate multi-resource operation options",
 			func(tc testCase) {
-				result, err := options.ParseAndRequireImmediateMulti(tc.defaults, tc.args...)
+				result, err := options.ParseAndRequireImmediateMulti(tc.defaults, tc.include, tc.args...)
 				if tc.expectedError != "" {
 					Expect(err).To(HaveOccurred())
 					Expect(err.Error()).To(ContainSubstring(tc.expectedError))
@@ -1387,6 +1863,7 @@ var _ = Describe("Options", func() {
 	Describe("ParseAndRequireImmediateTemplate", func() {
 		type testCase struct {
 			defaults      *options.Options
+			include       options.Include
 			args          []interface{}
 			expected      *options.Options
 			expectedError string
@@ -1394,7 +1871,7 @@ var _ = Describe("Options", func() {
 
 		DescribeTable("parsing and requiring immediate template operation options",
 			func(tc testCase) {
-				result, err := options.ParseAndRequireImmediateTemplate(tc.defaults, tc.args...)
+				result, err := options.ParseAndRequireImmediateTemplate(tc.defaults, tc.include, tc.args...)
 				if tc.expectedError != "" {
 					Expect(err).To(HaveOccurred())
 					Expect(err.Error()).To(ContainSubstring(tc.expectedError))
@@ -1572,4 +2049,231 @@ var _ = Describe("Options", func() {
 			}),
 		)
 	})
+
+	Describe("ParseAndRequireImmediateList", func() {
+		type testCase struct {
+			defaults      *options.Options
+			include       options.Include
+			args          []interface{}
+			expected      *options.Options
+			expectedError string
+		}
+
+		DescribeTable("parsing and requiring immediate list operation options",
+			func(tc testCase) {
+				result, err := options.ParseAndRequireImmediateList(tc.defaults, tc.include, tc.args...)
+				if tc.expectedError != "" {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(tc.expected))
+				}
+			},
+
+			// Valid arguments
+			Entry("valid template content", testCase{
+				defaults: nil,
+				args:     []interface{}{"template content"},
+				expected: &options.Options{
+					Template: "template content",
+					Bindings: map[string]any{},
+				},
+			}),
+
+			Entry("valid object list and list options", testCase{
+				defaults: nil,
+				include:  options.IncludeClientOptions,
+				args:     []interface{}{&corev1.ConfigMapList{}, client.InNamespace("default")},
+				expected: &options.Options{
+					Bindings:      map[string]any{},
+					ObjectList:    &corev1.ConfigMapList{},
+					ClientOptions: []interface{}{client.InNamespace("default")},
+				},
+			}),
+
+			Entry("valid template and unstructured object list", testCase{
+				defaults: nil,
+				args:     []interface{}{"template content", &unstructured.UnstructuredList{}},
+				expected: &options.Options{
+					Template:   "template content",
+					Bindings:   map[string]any{},
+					ObjectList: &unstructured.UnstructuredList{},
+				},
+			}),
+
+			// Invalid arguments
+			Entry("missing template and object list", testCase{
+				defaults:      nil,
+				args:          []interface{}{map[string]any{"key": "value"}},
+				expectedError: "required argument(s) not provided: Template (string) or ObjectList (client.ObjectList)",
+			}),
+
+			Entry("multiple object lists", testCase{
+				defaults:      nil,
+				args:          []interface{}{&corev1.ConfigMapList{}, &corev1.SecretList{}},
+				expectedError: "multiple client.ObjectList arguments provided",
+			}),
+
+			Entry("nil object list", testCase{
+				defaults:      nil,
+				args:          []interface{}{(*corev1.ConfigMapList)(nil)},
+				expectedError: "provided client.ObjectList is nil or has a nil underlying value",
+			}),
+
+			Entry("object not allowed", testCase{
+				defaults:      nil,
+				args:          []interface{}{"template content", testutil.NewConfigMap("test-config", "default", nil)},
+				expectedError: "unexpected argument type: *v1.ConfigMap",
+			}),
+		)
+	})
+
+	Describe("ParseAndRequireImmediateTemplateOnly", func() {
+		type testCase struct {
+			defaults      *options.Options
+			include       options.Include
+			args          []interface{}
+			expected      *options.Options
+			expectedError string
+		}
+
+		DescribeTable("parsing and requiring immediate template-only operation options",
+			func(tc testCase) {
+				result, err := options.ParseAndRequireImmediateTemplateOnly(tc.defaults, tc.include, tc.args...)
+				if tc.expectedError != "" {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(tc.expected))
+				}
+			},
+
+			// Valid arguments
+			Entry("valid template content", testCase{
+				defaults: nil,
+				args:     []interface{}{"template content"},
+				expected: &options.Options{
+					Template: "template content",
+					Bindings: map[string]any{},
+				},
+			}),
+
+			Entry("valid template, bindings, and match count", testCase{
+				defaults: nil,
+				include:  options.IncludeMatchCount,
+				args:     []interface{}{"template content", map[string]any{"key": "value"}, options.MatchCount{Min: 0, Max: 1}},
+				expected: &options.Options{
+					Template:   "template content",
+					Bindings:   map[string]any{"key": "value"},
+					MatchCount: &options.MatchCount{Min: 0, Max: 1},
+				},
+			}),
+
+			// Invalid arguments
+			Entry("missing template", testCase{
+				defaults:      nil,
+				args:          []interface{}{map[string]any{"key": "value"}},
+				expectedError: "required argument(s) not provided: Template (string)",
+			}),
+
+			Entry("object not allowed", testCase{
+				defaults:      nil,
+				args:          []interface{}{"template content", testutil.NewConfigMap("test-config", "default", nil)},
+				expectedError: "unexpected argument type: *v1.ConfigMap",
+			}),
+
+			Entry("objects not allowed", testCase{
+				defaults:      nil,
+				args:          []interface{}{"template content", []client.Object{testutil.NewConfigMap("test-config", "default", nil)}},
+				expectedError: "unexpected argument type: []client.Object",
+			}),
+		)
+	})
+
+	Describe("ParseAndRequireEventualObjectTemplate", func() {
+		type testCase struct {
+			defaults      *options.Options
+			include       options.Include
+			args          []interface{}
+			expected      *options.Options
+			expectedError string
+		}
+
+		obj := testutil.NewConfigMap("test-config", "default", nil)
+
+		DescribeTable("parsing and requiring eventual object template operation options",
+			func(tc testCase) {
+				result, err := options.ParseAndRequireEventualObjectTemplate(tc.defaults, tc.include, tc.args...)
+				if tc.expectedError != "" {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(tc.expected))
+				}
+			},
+
+			// Valid arguments
+			Entry("valid object and template with default durations", testCase{
+				defaults: &options.Options{Timeout: 5 * time.Second, Interval: time.Second},
+				args:     []interface{}{obj, "template content"},
+				expected: &options.Options{
+					Timeout:  5 * time.Second,
+					Interval: time.Second,
+					Template: "template content",
+					Bindings: map[string]any{},
+					Object:   obj,
+				},
+			}),
+
+			Entry("valid object, template, and durations", testCase{
+				defaults: &options.Options{Timeout: 5 * time.Second, Interval: time.Second},
+				args:     []interface{}{obj, "10s", "2s", "template content"},
+				expected: &options.Options{
+					Timeout:  10 * time.Second,
+					Interval: 2 * time.Second,
+					Template: "template content",
+					Bindings: map[string]any{},
+					Object:   obj,
+				},
+			}),
+
+			// Invalid arguments
+			Entry("missing object", testCase{
+				defaults:      &options.Options{Timeout: 5 * time.Second, Interval: time.Second},
+				args:          []interface{}{"template content"},
+				expectedError: "required argument(s) not provided: Object (client.Object)",
+			}),
+
+			Entry("missing template", testCase{
+				defaults:      &options.Options{Timeout: 5 * time.Second, Interval: time.Second},
+				args:          []interface{}{obj},
+				expectedError: "required argument(s) not provided: Template (string)",
+			}),
+
+			Entry("objects not allowed", testCase{
+				defaults:      &options.Options{Timeout: 5 * time.Second, Interval: time.Second},
+				args:          []interface{}{"template content", []client.Object{obj}},
+				expectedError: "unexpected argument type: []client.Object",
+			}),
+		)
+	})
+
+	DescribeTable("describing and applying match counts",
+		func(count options.MatchCount, expectedString string, allowed, disallowed []int) {
+			Expect(count.String()).To(Equal(expectedString))
+			for _, n := range allowed {
+				Expect(count.Allows(n)).To(BeTrue(), "expected %s to allow %d", count, n)
+			}
+			for _, n := range disallowed {
+				Expect(count.Allows(n)).To(BeFalse(), "expected %s not to allow %d", count, n)
+			}
+		},
+		Entry("exact", options.MatchCount{Min: 3, Max: 3}, "exactly 3", []int{3}, []int{2, 4}),
+		Entry("minimum", options.MatchCount{Min: 1, Unbounded: true}, "at least 1", []int{1, 100}, []int{0}),
+		Entry("maximum", options.MatchCount{Min: 0, Max: 1}, "at most 1", []int{0, 1}, []int{2}),
+		Entry("range", options.MatchCount{Min: 2, Max: 4}, "between 2 and 4", []int{2, 3, 4}, []int{1, 5}),
+	)
 })
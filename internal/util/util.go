@@ -1,10 +1,14 @@
 package util
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -24,6 +28,25 @@ func MergeMaps(maps ...map[string]any) map[string]any {
 	return merged
 }
 
+// MergeMapsDeep merges the overlay into a copy of the base. Nested maps are merged
+// recursively; all other overlay values (including slices) replace base values.
+func MergeMapsDeep(base, overlay map[string]any) map[string]any {
+	merged := make(map[string]any, len(base))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range overlay {
+		baseMap, baseOk := merged[k].(map[string]any)
+		overlayMap, overlayOk := v.(map[string]any)
+		if baseOk && overlayOk {
+			merged[k] = MergeMapsDeep(baseMap, overlayMap)
+		} else {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
 // IsExistingFile checks if the given path exists and is a file.
 func IsExistingFile(path string) bool {
 	info, err := os.Stat(path)
@@ -88,6 +111,18 @@ func AsObject(v interface{}) (client.Object, bool) {
 	return nil, false
 }
 
+// AsObjectList attempts to convert the given value into a client.ObjectList. Values that also implement
+// client.Object (e.g. *unstructured.Unstructured) are not considered lists.
+func AsObjectList(v interface{}) (client.ObjectList, bool) {
+	if _, ok := v.(client.Object); ok {
+		return nil, false
+	}
+	if list, ok := v.(client.ObjectList); ok {
+		return list, true
+	}
+	return nil, false
+}
+
 // AsSliceOfObjects attempts to convert the given value into a slice of client.Object.
 func AsSliceOfObjects(v interface{}) ([]client.Object, bool) {
 	// Check if it's already a []client.Object
@@ -118,6 +153,59 @@ func AsSliceOfObjects(v interface{}) ([]client.Object, bool) {
 	return objs, true
 }
 
+// AsClientOption checks if the given value is a controller-runtime client option
+// (e.g. client.FieldOwner, client.DryRunAll, or client.PropagationPolicy).
+func AsClientOption(v interface{}) (interface{}, bool) {
+	switch v.(type) {
+	case client.CreateOption, client.UpdateOption, client.PatchOption,
+		client.DeleteOption, client.DeleteAllOfOption, client.GetOption, client.ListOption:
+		return v, true
+	default:
+		return nil, false
+	}
+}
+
+// AsMutation checks if the given value is a function that takes a single client.Object implementation
+// (e.g. client.Object or *corev1.ConfigMap) and returns nothing or an error. If so, it is wrapped in a
+// function that returns an error if the object passed to it has the wrong type.
+func AsMutation(v interface{}) (func(client.Object) error, bool) {
+	fn := reflect.ValueOf(v)
+	if fn.Kind() != reflect.Func || fn.IsNil() {
+		return nil, false
+	}
+	fnType := fn.Type()
+	objectType := reflect.TypeOf((*client.Object)(nil)).Elem()
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+	if fnType.NumIn() != 1 || !fnType.In(0).Implements(objectType) {
+		return nil, false
+	}
+	if fnType.NumOut() > 1 || (fnType.NumOut() == 1 && fnType.Out(0) != errorType) {
+		return nil, false
+	}
+	inType := fnType.In(0)
+	return func(obj client.Object) error {
+		if obj == nil || !reflect.TypeOf(obj).AssignableTo(inType) {
+			return fmt.Errorf("mutation function expects %v but got %T", inType, obj)
+		}
+		out := fn.Call([]reflect.Value{reflect.ValueOf(obj)})
+		if len(out) == 1 && !out[0].IsNil() {
+			return out[0].Interface().(error)
+		}
+		return nil
+	}, true
+}
+
+// FilterByType returns the elements of the given slice that implement type T.
+func FilterByType[T any](values []interface{}) []T {
+	var filtered []T
+	for _, v := range values {
+		if t, ok := v.(T); ok {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered
+}
+
 // IsNil checks if the given interface is nil
 // or has a nil underlying value.
 func IsNil(v interface{}) bool {
@@ -220,6 +308,21 @@ func GetGroupVersionKind(obj client.Object, scheme *runtime.Scheme) (schema.Grou
 	return gvk, nil
 }
 
+// UnstructuredRefFromObject returns an unstructured object containing only the identifying metadata
+// (GroupVersionKind, namespace, and name) of the given object. If the object's GVK is empty, it
+// attempts to get it from the scheme.
+func UnstructuredRefFromObject(obj client.Object, scheme *runtime.Scheme) (unstructured.Unstructured, error) {
+	gvk, err := GetGroupVersionKind(obj, scheme)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	ref := unstructured.Unstructured{}
+	ref.SetGroupVersionKind(gvk)
+	ref.SetNamespace(obj.GetNamespace())
+	ref.SetName(obj.GetName())
+	return ref, nil
+}
+
 // UnstructuredFromObject uses the client scheme to convert
 // the given object to an unstructured object.
 func UnstructuredFromObject(
@@ -299,3 +402,66 @@ func GetResourceID(obj client.Object, scheme *runtime.Scheme) string {
 	keyString := strings.TrimLeft(key.String(), "/")
 	return fmt.Sprintf("%s (%s)", kind, keyString)
 }
+
+// CompareResourceVersions compares two resource versions, returning -1, 0, or 1. Resource versions
+// are compared numerically if both are unsigned integers (as with etcd-backed API servers), and
+// lexically otherwise.
+func CompareResourceVersions(a, b string) int {
+	aInt, aErr := strconv.ParseUint(a, 10, 64)
+	bInt, bErr := strconv.ParseUint(b, 10, 64)
+	if aErr != nil || bErr != nil {
+		return strings.Compare(a, b)
+	}
+	return cmp.Compare(aInt, bInt)
+}
+
+// GetObservedGeneration returns the integer value of the field at the given dot-separated path
+// of the object (e.g. "status.observedGeneration"), and whether the field was found.
+func GetObservedGeneration(obj client.Object, path string) (int64, bool, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return 0, false, err
+	}
+	value, found, err := unstructured.NestedFieldNoCopy(content, strings.Split(path, ".")...)
+	if err != nil || !found {
+		return 0, false, err
+	}
+	switch v := value.(type) {
+	case int64:
+		return v, true, nil
+	case int:
+		return int64(v), true, nil
+	case float64:
+		return int64(v), true, nil
+	default:
+		return 0, false, fmt.Errorf("field %s has non-integer type %T", path, value)
+	}
+}
+
+// ForEach calls fn for each index in [0, n) using up to limit concurrent goroutines.
+//   - If limit is less than 2, calls fn sequentially and returns the first error.
+//   - Otherwise, calls fn for all indices and returns all errors joined in index order.
+func ForEach(limit, n int, fn func(i int) error) error {
+	if limit < 2 {
+		for i := 0; i < n; i++ {
+			if err := fn(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	errs := make([]error, n)
+	semaphore := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		semaphore <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			errs[i] = fn(i)
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
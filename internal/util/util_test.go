@@ -1,12 +1,16 @@
 package util_test
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sync/atomic"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -103,6 +107,51 @@ var _ = Describe("Util", func() {
 		)
 	})
 
+	Describe("MergeMapsDeep", func() {
+		type testCase struct {
+			base     map[string]any
+			overlay  map[string]any
+			expected map[string]any
+		}
+
+		DescribeTable("deep merging maps",
+			func(tc testCase) {
+				result := util.MergeMapsDeep(tc.base, tc.overlay)
+				Expect(result).To(Equal(tc.expected))
+			},
+			Entry("nil maps", testCase{
+				base:     nil,
+				overlay:  nil,
+				expected: map[string]any{},
+			}),
+			Entry("nested maps are merged", testCase{
+				base: map[string]any{
+					"metadata": map[string]any{"name": "test", "labels": map[string]any{"a": "1"}},
+					"data":     map[string]any{"key1": "value1"},
+				},
+				overlay: map[string]any{
+					"metadata": map[string]any{"labels": map[string]any{"b": "2"}},
+					"data":     map[string]any{"key2": "value2"},
+				},
+				expected: map[string]any{
+					"metadata": map[string]any{"name": "test", "labels": map[string]any{"a": "1", "b": "2"}},
+					"data":     map[string]any{"key1": "value1", "key2": "value2"},
+				},
+			}),
+			Entry("slices and scalars are replaced", testCase{
+				base:     map[string]any{"list": []any{"a", "b"}, "value": 1, "nested": map[string]any{"x": 1}},
+				overlay:  map[string]any{"list": []any{"c"}, "value": 2, "nested": "replaced"},
+				expected: map[string]any{"list": []any{"c"}, "value": 2, "nested": "replaced"},
+			}),
+		)
+
+		It("does not modify the base map", func() {
+			base := map[string]any{"data": map[string]any{"key1": "value1"}}
+			util.MergeMapsDeep(base, map[string]any{"data": map[string]any{"key2": "value2"}})
+			Expect(base).To(Equal(map[string]any{"data": map[string]any{"key1": "value1"}}))
+		})
+	})
+
 	Describe("IsExistingFile", func() {
 		type testCase struct {
 			setup    func() string
@@ -319,6 +368,40 @@ var _ = Describe("Util", func() {
 		)
 	})
 
+	Describe("AsObjectList", func() {
+		type testCase struct {
+			input      interface{}
+			expectedOk bool
+		}
+
+		DescribeTable("converting to client.ObjectList",
+			func(tc testCase) {
+				_, ok := util.AsObjectList(tc.input)
+				Expect(ok).To(Equal(tc.expectedOk))
+			},
+			Entry("input is a typed list", testCase{
+				input:      &corev1.ConfigMapList{},
+				expectedOk: true,
+			}),
+			Entry("input is an unstructured list", testCase{
+				input:      &unstructured.UnstructuredList{},
+				expectedOk: true,
+			}),
+			Entry("input is an unstructured object", testCase{
+				input:      &unstructured.Unstructured{},
+				expectedOk: false,
+			}),
+			Entry("input is a typed object", testCase{
+				input:      &corev1.ConfigMap{},
+				expectedOk: false,
+			}),
+			Entry("input is nil", testCase{
+				input:      nil,
+				expectedOk: false,
+			}),
+		)
+	})
+
 	Describe("AsSliceOfObjects", func() {
 		type testCase struct {
 			input          interface{}
@@ -372,6 +455,139 @@ var _ = Describe("Util", func() {
 		)
 	})
 
+	Describe("AsClientOption", func() {
+		type testCase struct {
+			input      interface{}
+			expectedOk bool
+		}
+
+		DescribeTable("converting to client option",
+			func(tc testCase) {
+				_, ok := util.AsClientOption(tc.input)
+				Expect(ok).To(Equal(tc.expectedOk))
+			},
+			Entry("input is a field owner", testCase{
+				input:      client.FieldOwner("test"),
+				expectedOk: true,
+			}),
+			Entry("input is force ownership", testCase{
+				input:      client.ForceOwnership,
+				expectedOk: true,
+			}),
+			Entry("input is dry run", testCase{
+				input:      client.DryRunAll,
+				expectedOk: true,
+			}),
+			Entry("input is a grace period", testCase{
+				input:      client.GracePeriodSeconds(0),
+				expectedOk: true,
+			}),
+			Entry("input is matching labels", testCase{
+				input:      client.MatchingLabels{"app": "test"},
+				expectedOk: true,
+			}),
+			Entry("input is a string", testCase{
+				input:      "not an option",
+				expectedOk: false,
+			}),
+			Entry("input is a map", testCase{
+				input:      map[string]any{"key": "value"},
+				expectedOk: false,
+			}),
+			Entry("input is nil", testCase{
+				input:      nil,
+				expectedOk: false,
+			}),
+		)
+	})
+
+	Describe("AsMutation", func() {
+		type testCase struct {
+			input      interface{}
+			expectedOk bool
+		}
+
+		DescribeTable("converting to mutation",
+			func(tc testCase) {
+				_, ok := util.AsMutation(tc.input)
+				Expect(ok).To(Equal(tc.expectedOk))
+			},
+			Entry("input takes client.Object", testCase{
+				input:      func(client.Object) {},
+				expectedOk: true,
+			}),
+			Entry("input takes typed object and returns error", testCase{
+				input:      func(*corev1.ConfigMap) error { return nil },
+				expectedOk: true,
+			}),
+			Entry("input takes unstructured object", testCase{
+				input:      func(*unstructured.Unstructured) {},
+				expectedOk: true,
+			}),
+			Entry("input takes non-object", testCase{
+				input:      func(string) {},
+				expectedOk: false,
+			}),
+			Entry("input returns non-error", testCase{
+				input:      func(client.Object) bool { return true },
+				expectedOk: false,
+			}),
+			Entry("input takes multiple arguments", testCase{
+				input:      func(client.Object, client.Object) {},
+				expectedOk: false,
+			}),
+			Entry("input is nil function", testCase{
+				input:      (func(client.Object))(nil),
+				expectedOk: false,
+			}),
+			Entry("input is a string", testCase{
+				input:      "not a function",
+				expectedOk: false,
+			}),
+		)
+
+		It("calls the function with matching objects and returns its error", func() {
+			mutate, ok := util.AsMutation(func(cm *corev1.ConfigMap) error {
+				cm.Data = map[string]string{"key": "mutated"}
+				return errors.New("mutation error")
+			})
+			Expect(ok).To(BeTrue())
+			cm := &corev1.ConfigMap{}
+			Expect(mutate(cm)).To(MatchError("mutation error"))
+			Expect(cm.Data).To(Equal(map[string]string{"key": "mutated"}))
+		})
+
+		It("returns an error for objects of the wrong type", func() {
+			mutate, ok := util.AsMutation(func(*corev1.ConfigMap) {})
+			Expect(ok).To(BeTrue())
+			err := mutate(&unstructured.Unstructured{})
+			Expect(err).To(MatchError(ContainSubstring("mutation function expects *v1.ConfigMap")))
+		})
+	})
+
+	Describe("FilterByType", func() {
+		It("returns only values implementing the type", func() {
+			values := []interface{}{
+				client.FieldOwner("test"),
+				client.GracePeriodSeconds(0),
+				client.ForceOwnership,
+				"not an option",
+			}
+			Expect(util.FilterByType[client.PatchOption](values)).To(Equal([]client.PatchOption{
+				client.FieldOwner("test"),
+				client.ForceOwnership,
+			}))
+			Expect(util.FilterByType[client.DeleteOption](values)).To(Equal([]client.DeleteOption{
+				client.GracePeriodSeconds(0),
+			}))
+		})
+
+		It("returns nil when no values implement the type", func() {
+			Expect(util.FilterByType[client.CreateOption]([]interface{}{"not an option"})).To(BeNil())
+			Expect(util.FilterByType[client.CreateOption](nil)).To(BeNil())
+		})
+	})
+
 	Describe("IsNil", func() {
 		type testCase struct {
 			input    interface{}
@@ -1182,6 +1398,50 @@ var _ = Describe("Util", func() {
 		})
 	})
 
+	Describe("UnstructuredRefFromObject", func() {
+		It("returns identifying metadata of typed object without TypeMeta", func() {
+			ref, err := util.UnstructuredRefFromObject(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-cm",
+					Namespace: "default",
+					Labels:    map[string]string{"ignored": "label"},
+				},
+				Data: map[string]string{"ignored": "data"},
+			}, standardScheme)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ref.Object).To(Equal(map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]interface{}{
+					"name":      "test-cm",
+					"namespace": "default",
+				},
+			}))
+		})
+
+		It("returns identifying metadata of cluster-scoped unstructured object", func() {
+			obj := &unstructured.Unstructured{}
+			obj.SetAPIVersion("v1")
+			obj.SetKind("Namespace")
+			obj.SetName("test-ns")
+			ref, err := util.UnstructuredRefFromObject(obj, standardScheme)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ref.Object).To(Equal(map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Namespace",
+				"metadata": map[string]interface{}{
+					"name": "test-ns",
+				},
+			}))
+		})
+
+		It("returns error when GVK cannot be determined", func() {
+			_, err := util.UnstructuredRefFromObject(&corev1.ConfigMap{}, runtime.NewScheme())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to get GroupVersionKind for object"))
+		})
+	})
+
 	Describe("GetResourceID", func() {
 		type testCase struct {
 			object     client.Object
@@ -1249,4 +1509,110 @@ var _ = Describe("Util", func() {
 			}),
 		)
 	})
+
+	Describe("CompareResourceVersions", func() {
+		DescribeTable("comparing resource versions",
+			func(a, b string, expected int) {
+				Expect(util.CompareResourceVersions(a, b)).To(Equal(expected))
+			},
+			Entry("equal integers", "42", "42", 0),
+			Entry("lesser integer", "9", "10", -1),
+			Entry("greater integer", "100", "99", 1),
+			Entry("empty and integer", "", "1", -1),
+			Entry("non-integers", "abc", "abd", -1),
+		)
+	})
+
+	Describe("ForEach", func() {
+		It("should stop at the first error when sequential", func() {
+			var called []int
+			err := util.ForEach(1, 5, func(i int) error {
+				called = append(called, i)
+				if i == 2 {
+					return fmt.Errorf("error %d", i)
+				}
+				return nil
+			})
+			Expect(err).To(MatchError("error 2"))
+			Expect(called).To(Equal([]int{0, 1, 2}))
+		})
+
+		It("should call all indices and join errors when concurrent", func() {
+			var calls, active, maxActive int32
+			err := util.ForEach(3, 10, func(i int) error {
+				atomic.AddInt32(&calls, 1)
+				current := atomic.AddInt32(&active, 1)
+				defer atomic.AddInt32(&active, -1)
+				for {
+					previous := atomic.LoadInt32(&maxActive)
+					if current <= previous || atomic.CompareAndSwapInt32(&maxActive, previous, current) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				if i%4 == 0 {
+					return fmt.Errorf("error %d", i)
+				}
+				return nil
+			})
+			Expect(err).To(MatchError("error 0\nerror 4\nerror 8"))
+			Expect(calls).To(Equal(int32(10)))
+			Expect(maxActive).To(BeNumerically("<=", 3))
+		})
+
+		It("should succeed with no indices", func() {
+			Expect(util.ForEach(4, 0, func(int) error { return fmt.Errorf("unexpected") })).To(Succeed())
+		})
+	})
+
+	Describe("GetObservedGeneration", func() {
+		type testCase struct {
+			object        client.Object
+			path          string
+			expectedValue int64
+			expectedFound bool
+			expectedErr   string
+		}
+
+		DescribeTable("getting observed generations",
+			func(tc testCase) {
+				value, found, err := util.GetObservedGeneration(tc.object, tc.path)
+				if tc.expectedErr != "" {
+					Expect(err).To(MatchError(ContainSubstring(tc.expectedErr)))
+					return
+				}
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(Equal(tc.expectedFound))
+				Expect(value).To(Equal(tc.expectedValue))
+			},
+			Entry("typed object", testCase{
+				object: &appsv1.Deployment{
+					Status: appsv1.DeploymentStatus{ObservedGeneration: 3},
+				},
+				path:          "status.observedGeneration",
+				expectedValue: 3,
+				expectedFound: true,
+			}),
+			Entry("unstructured object with custom path", testCase{
+				object: &unstructured.Unstructured{Object: map[string]any{
+					"status": map[string]any{"custom": map[string]any{"generation": int64(2)}},
+				}},
+				path:          "status.custom.generation",
+				expectedValue: 2,
+				expectedFound: true,
+			}),
+			Entry("field not found", testCase{
+				object:        &corev1.ConfigMap{},
+				path:          "status.observedGeneration",
+				expectedFound: false,
+			}),
+			Entry("non-integer field", testCase{
+				object: &unstructured.Unstructured{Object: map[string]any{
+					"status": map[string]any{"observedGeneration": "3"},
+				}},
+				path:        "status.observedGeneration",
+				expectedErr: "field status.observedGeneration has non-integer type string",
+			}),
+		)
+	})
 })
@@ -3,17 +3,30 @@ package sawchain
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"reflect"
+	"slices"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apitypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/yaml"
 
 	"github.com/eolatham/sawchain/internal/chainsaw"
@@ -22,25 +35,83 @@ import (
 	"github.com/eolatham/sawchain/internal/util"
 )
 
+// DefaultFieldManager is the field manager used for server-side apply operations
+// unless a client.FieldOwner argument is provided.
+const DefaultFieldManager = "sawchain"
+
+// GeneratedNamesBinding is the name of the global binding that maps the metadata.generateName prefix of
+// each resource created by Create to the server-assigned name of the most recent resource created with it,
+// allowing later template operations to refer to generated resources (e.g. `($generatedNames."test-")`).
+const GeneratedNamesBinding = "generatedNames"
+
+// NamespaceBinding is the name of the global binding set to the name of the ephemeral namespace created by
+// EphemeralNamespace (e.g. `namespace: ($namespace)`).
+const NamespaceBinding = "namespace"
+
+// EphemeralNamespacePrefix is the metadata.generateName prefix of ephemeral namespaces.
+const EphemeralNamespacePrefix = "sawchain-"
+
 const (
 	errInvalidArgs        = "invalid arguments"
 	errInvalidTemplate    = "invalid template/bindings"
 	errObjectInsufficient = "single object insufficient for multi-resource template"
 	errObjectsWrongLength = "objects slice length must match template resource count"
+	errPatchTypeInvalid   = "patch type must be merge, strategic merge, or JSON patch"
+	errJSONPatchNoObject  = "JSON patch requires an object or objects to identify the resources to patch"
+	errScaleNoReplicas    = "spec.replicas is required to update the scale subresource"
+	errScaleWrongObject   = "scale subresource reads only save to *autoscalingv1.Scale objects"
+	errScaleGetNoTemplate = "Get requires a template to read the scale subresource; use FetchSingle to fetch the scale of an object"
 
 	errCacheNotSynced = "client cache not synced within timeout"
+	errNotReconciled  = "resource not reconciled within timeout"
 	errFailedSave     = "failed to save state to object"
 	errFailedConvert  = "failed to convert return object to typed"
 	errFailedWrite    = "failed to write file"
+	errFailedCleanup  = "failed to clean up created resource"
+
+	errCheckNotEventual   = "check did not succeed within timeout"
+	errCheckNotConsistent = "check did not keep succeeding for the whole duration"
+	errMatchNotEventual   = "object did not match template within timeout"
+
+	errCRDNotEstablished = "CRD not established within timeout"
+
+	errFailedCreateNamespace  = "failed to create ephemeral namespace"
+	errNamespaceNotTerminated = "ephemeral namespace not terminated within timeout"
+
+	errFailedRemoveFinalizers = "failed to remove finalizers"
+
+	errFailedConvertToUnstructured = "failed to convert object to unstructured"
 
 	errFailedCreateWithTemplate = "failed to create with template"
 	errFailedCreateWithObject   = "failed to create with object"
 	errFailedUpdateWithTemplate = "failed to update with template"
 	errFailedUpdateWithObject   = "failed to update with object"
+	errFailedUpsertWithTemplate = "failed to upsert with template"
+	errFailedUpsertWithObject   = "failed to upsert with object"
+	errFailedApplyWithTemplate  = "failed to apply with template"
+	errFailedApplyWithObject    = "failed to apply with object"
+	errFailedPatchWithTemplate  = "failed to patch with template"
+	errFailedPatchWithObject    = "failed to patch with object"
+	errFailedMutate             = "failed to mutate"
 	errFailedDeleteWithTemplate = "failed to delete with template"
 	errFailedDeleteWithObject   = "failed to delete with object"
 	errFailedGetWithTemplate    = "failed to get with template"
 	errFailedGetWithObject      = "failed to get with object"
+	errFailedGetLiveState       = "failed to get live state to merge template onto"
+	errFailedListWithTemplate   = "failed to list with template"
+	errFailedListWithList       = "failed to list with object list"
+
+	errListTemplateNamed = "list template must not contain a resource name"
+	errListTypeMismatch  = "object list type must match template resource type"
+
+	errUnexpectedCreateSuccess = "expected create to be rejected, but all resources were created"
+	errUnexpectedUpdateSuccess = "expected update to be rejected, but all resources were updated"
+
+	errWrongMatchCount = "expected %s matching resource(s) but found %d"
+	errUnexpectedMatch = "expected no matching resources but found %d"
+
+	errTypeNotPointer = "type parameter must be a pointer to a struct type (e.g. *corev1.ConfigMap)"
+	errWrongType      = "fetched object type doesn't match type parameter"
 
 	errNilOpts             = "internal error: parsed options is nil"
 	errFailedReadTemplate  = "internal error: failed to read template file"
@@ -48,14 +119,123 @@ const (
 	errCreatedMatcherIsNil = "internal error: created matcher is nil"
 )
 
+// Option types accepted by the constructor and each operation, in addition to durations, objects,
+// templates, and bindings. Other option types are rejected as invalid arguments.
+const (
+	includeNone options.Include = 0
+
+	includeGlobal = options.IncludeSkipCleanup | options.IncludeRemoveFinalizers | options.IncludeMergeOntoLive |
+		options.IncludeObservedGenerationPath | options.IncludeConcurrency
+
+	includeCreate = options.IncludeClientOptions | options.IncludeSkipCleanup |
+		options.IncludeObservedGenerationPath | options.IncludeConcurrency
+
+	includeUpdate = options.IncludeClientOptions | options.IncludeMergeOntoLive | options.IncludeSubresource |
+		options.IncludeObservedGenerationPath | options.IncludeConcurrency
+
+	includeUpsert = options.IncludeClientOptions | options.IncludeSkipCleanup |
+		options.IncludeObservedGenerationPath | options.IncludeConcurrency
+
+	includePatch = options.IncludeClientOptions | options.IncludePatchType | options.IncludeSubresource |
+		options.IncludeObservedGenerationPath
+
+	includeMutate = options.IncludeClientOptions | options.IncludeSubresource | options.IncludeObservedGenerationPath
+	includeApply  = options.IncludeClientOptions | options.IncludeObservedGenerationPath
+	includeDelete = options.IncludeClientOptions | options.IncludeRemoveFinalizers | options.IncludeConcurrency
+
+	includeCreateExpectError = options.IncludeClientOptions
+	includeUpdateExpectError = options.IncludeClientOptions | options.IncludeSubresource
+
+	includeGet      = options.IncludeSubresource
+	includeList     = options.IncludeClientOptions
+	includeCheckAll = options.IncludeMatchCount
+)
+
+// SkipCleanup is an argument that disables automatic cleanup of resources created by Sawchain. It may be
+// provided to New to disable cleanup globally or to Create to disable cleanup for a single operation.
+const SkipCleanup = options.SkipCleanup(true)
+
+// RemoveFinalizers is an argument that removes finalizers from resources that are not deleted within
+// the timeout (e.g. because no controller is running to handle the finalizers), then waits for deletion
+// again. It may be provided to New to enable it globally (including for automatic cleanup) or to Delete
+// to enable it for a single operation.
+const RemoveFinalizers = options.RemoveFinalizers(true)
+
+// MergeOntoLive is an argument that makes Update deep merge rendered template documents onto the live
+// state of the resources before updating them, so that templates only need the identifying metadata of
+// each resource plus the fields to change. Fields populated by controllers or defaulting are preserved
+// rather than reverted. It may be provided to New to enable it globally or to Update to enable it for a
+// single operation.
+const MergeOntoLive = options.MergeOntoLive(true)
+
+// Subresource arguments target a subresource of resources instead of the main resource. They may be
+// provided to Update, UpdateExpectError, Patch, Mutate, Get, GetFunc, FetchSingle, and FetchSingleFunc.
+const (
+	// StatusSubresource targets the status subresource, which has the same kind as the resource.
+	StatusSubresource = options.Subresource("status")
+	// ScaleSubresource targets the scale subresource, which is read and written as an autoscaling/v1
+	// Scale. Scale writes only use the spec.replicas field of the provided resource definitions. Scale reads
+	// never write into the resource itself; they save to *autoscalingv1.Scale objects or return the Scale.
+	ScaleSubresource = options.Subresource("scale")
+)
+
+// WaitForReconciliation is an argument that makes write operations (Create, Update, Upsert, Apply, Patch,
+// and Mutate) wait until the status.observedGeneration of each resource reaches its metadata.generation,
+// indicating that a controller has reconciled the latest changes. Resources without a generation are not
+// checked. It may be provided to New to enable it globally or to a write operation to enable it for a
+// single operation.
+const WaitForReconciliation = options.ObservedGenerationPath("status.observedGeneration")
+
+// WaitForReconciliationAt returns an argument like WaitForReconciliation that reads the observed generation
+// from the field at the given dot-separated path instead (e.g. "status.atProvider.observedGeneration").
+func WaitForReconciliationAt(path string) options.ObservedGenerationPath {
+	return options.ObservedGenerationPath(path)
+}
+
+// Concurrency returns an argument that makes Create, Update, Upsert, and Delete operations on multiple
+// resources issue up to n API calls at a time instead of one, reporting all errors together instead of
+// stopping at the first one. Cache sync and reconciliation checks are parallelized the same way. Resources
+// are still created and deleted in dependency order (only resources of the same dependency rank are
+// handled concurrently). It may be provided to New to set it globally or to an operation to set it for a
+// single operation.
+func Concurrency(n int) options.Concurrency {
+	return options.Concurrency(n)
+}
+
+// ExactMatches returns an argument that makes CheckAll and CheckAllFunc require exactly n resources
+// matching the template. A negative n results in immediate test failure.
+func ExactMatches(n int) options.MatchCount {
+	return options.MatchCount{Min: n, Max: n}
+}
+
+// MinMatches returns an argument that makes CheckAll and CheckAllFunc require at least n resources
+// matching the template. A negative n results in immediate test failure.
+func MinMatches(n int) options.MatchCount {
+	return options.MatchCount{Min: n, Unbounded: true}
+}
+
+// MaxMatches returns an argument that makes CheckAll and CheckAllFunc require at most n resources
+// matching the template (including none). A negative n results in immediate test failure.
+func MaxMatches(n int) options.MatchCount {
+	return options.MatchCount{Min: 0, Max: n}
+}
+
+// MatchesBetween returns an argument that makes CheckAll and CheckAllFunc require between min and max
+// resources (inclusive) matching the template. A negative min or a max less than min results in
+// immediate test failure.
+func MatchesBetween(min, max int) options.MatchCount {
+	return options.MatchCount{Min: min, Max: max}
+}
+
 // Sawchain provides utilities for K8s YAML-driven testing—backed by Chainsaw. It includes helpers to
 // reliably create/update/delete test resources, Gomega-friendly APIs to simplify assertions, and more.
 // Use New to create a Sawchain instance.
 type Sawchain struct {
-	t    testing.TB
-	g    gomega.Gomega
-	c    client.Client
-	opts options.Options
+	t         testing.TB
+	g         gomega.Gomega
+	c         client.Client
+	opts      options.Options
+	namespace string
 }
 
 // New creates a new Sawchain instance with the provided global settings.
@@ -75,6 +255,20 @@ type Sawchain struct {
 //   - Interval (string or time.Duration): Optional. Defaults to 1s. Default polling interval for
 //     eventual assertions. If provided, must be after timeout.
 //
+//   - SkipCleanup: Optional. Disables automatic cleanup of resources created by Sawchain.
+//
+//   - MergeOntoLive: Optional. Makes all Update operations with templates merge the templates onto the
+//     live state of the resources.
+//
+//   - RemoveFinalizers: Optional. Removes finalizers from resources that are not deleted within the
+//     timeout by Delete operations and automatic cleanup.
+//
+//   - WaitForReconciliation (or WaitForReconciliationAt): Optional. Makes all write operations wait for
+//     controllers to reconcile the written resources before returning.
+//
+//   - Concurrency: Optional. Defaults to 1. Maximum number of concurrent API calls for operations on
+//     multiple resources.
+//
 // # Examples
 //
 // Create a Sawchain instance with the default settings:
@@ -88,6 +282,18 @@ type Sawchain struct {
 // Create a Sawchain instance with custom timeout and interval settings:
 //
 //	sc := sawchain.New(t, k8sClient, "10s", "2s")
+//
+// Create a Sawchain instance that leaves created resources in the cluster after the test:
+//
+//	sc := sawchain.New(t, k8sClient, sawchain.SkipCleanup)
+//
+// Create a Sawchain instance that waits for controllers to reconcile written resources:
+//
+//	sc := sawchain.New(t, k8sClient, sawchain.WaitForReconciliation)
+//
+// Create a Sawchain instance that handles up to 20 resources at a time in bulk operations:
+//
+//	sc := sawchain.New(t, k8sClient, sawchain.Concurrency(20))
 func New(t testing.TB, c client.Client, args ...interface{}) *Sawchain {
 	t.Helper()
 	// Create Gomega
@@ -98,19 +304,128 @@ func New(t testing.TB, c client.Client, args ...interface{}) *Sawchain {
 	opts, err := options.ParseAndRequireGlobal(&options.Options{
 		Timeout:  time.Second * 5,
 		Interval: time.Second,
-	}, args...)
+	}, includeGlobal, args...)
 	g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 	// Instantiate Sawchain
 	return &Sawchain{t: t, g: g, c: c, opts: *opts}
 }
 
+// EphemeralNamespace creates a uniquely named namespace for the test (if not already created by this
+// Sawchain instance), ensures client Get operations for it succeed within the default timeout, and
+// returns its name.
+//
+// Once created, the namespace is:
+//
+//   - Bound as $namespace (NamespaceBinding) in all subsequent Chainsaw template operations.
+//
+//   - Set on namespaced resources that omit a namespace in all subsequent operations that create, update,
+//     delete, or read resources (i.e. all operations except Check, CheckFunc, and rendering operations).
+//
+//   - Deleted when the test ends (unless SkipCleanup was provided to New), after all resources created
+//     later in the test. Since namespaces are finalized by the namespace controller, which doesn't run in
+//     envtest, a namespace is considered terminated once it is gone or in the Terminating phase.
+//
+// This allows tests running in parallel against the same cluster (e.g. parallel Ginkgo nodes sharing
+// one envtest environment) to use the same templates without colliding on namespace names.
+//
+// Client errors and timeout errors will result in immediate test failure.
+//
+// # Examples
+//
+// Create resources in an ephemeral namespace:
+//
+//	sc := sawchain.New(t, k8sClient)
+//	sc.EphemeralNamespace(ctx)
+//	sc.Create(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: test-cm
+//	`)
+//
+// Refer to the ephemeral namespace in a template:
+//
+//	sc.Check(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: test-cm
+//	    namespace: ($namespace)
+//	`)
+func (s *Sawchain) EphemeralNamespace(ctx context.Context) string {
+	s.t.Helper()
+	if s.namespace != "" {
+		return s.namespace
+	}
+
+	// Create namespace
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{GenerateName: EphemeralNamespacePrefix}}
+	s.g.Expect(s.c.Create(ctx, namespace)).To(gomega.Succeed(), errFailedCreateNamespace)
+
+	// Delete namespace when the test ends
+	if !s.opts.SkipCleanup {
+		cleanupNamespace := namespace.DeepCopy()
+		cleanupCtx := context.WithoutCancel(ctx)
+		s.t.Cleanup(func() {
+			s.t.Helper()
+			s.g.Expect(client.IgnoreNotFound(s.c.Delete(cleanupCtx, cleanupNamespace))).To(gomega.Succeed(), errFailedCleanup)
+			s.g.Eventually(s.checkNamespaceTerminatedF(cleanupCtx, cleanupNamespace), s.opts.Timeout, s.opts.Interval).
+				Should(gomega.Succeed(), errNamespaceNotTerminated)
+		})
+	}
+
+	// Wait for cache to sync
+	s.waitForCacheSync(s.getF(ctx, namespace), &s.opts)
+
+	// Bind namespace
+	s.namespace = namespace.Name
+	s.opts.Bindings = util.MergeMaps(s.opts.Bindings, map[string]any{NamespaceBinding: s.namespace})
+	return s.namespace
+}
+
 // HELPER FUNCTIONS
 
 func (s *Sawchain) mergeBindings(bindings ...map[string]any) map[string]any {
 	return util.MergeMaps(append([]map[string]any{s.opts.Bindings}, bindings...)...)
 }
 
+func (s *Sawchain) recordGeneratedName(obj client.Object, opts *options.Options) {
+	if obj.GetGenerateName() == "" || isDryRun(opts) {
+		return
+	}
+	generatedNames := map[string]any{}
+	if existing, ok := s.opts.Bindings[GeneratedNamesBinding].(map[string]any); ok {
+		generatedNames = util.MergeMaps(existing)
+	}
+	generatedNames[obj.GetGenerateName()] = obj.GetName()
+	s.opts.Bindings = util.MergeMaps(s.opts.Bindings, map[string]any{GeneratedNamesBinding: generatedNames})
+}
+
+// defaultNamespace sets the ephemeral namespace (if any) on namespaced objects that omit a namespace.
+// Objects whose scope is unknown to the client's RESTMapper (e.g. custom resources whose CRDs don't
+// exist yet) are left unchanged.
+func (s *Sawchain) defaultNamespace(objs ...client.Object) {
+	if s.namespace == "" {
+		return
+	}
+	for _, obj := range objs {
+		if util.IsNil(obj) || obj.GetNamespace() != "" {
+			continue
+		}
+		if namespaced, err := s.c.IsObjectNamespaced(obj); err == nil && namespaced {
+			obj.SetNamespace(s.namespace)
+		}
+	}
+}
+
+func (s *Sawchain) defaultObjectNamespaces(opts *options.Options) {
+	if opts.Object != nil {
+		s.defaultNamespace(opts.Object)
+	}
+	s.defaultNamespace(opts.Objects...)
+}
+
 func (s *Sawchain) id(obj client.Object) string {
 	return util.GetResourceID(obj, s.c.Scheme())
 }
@@ -128,7 +443,7 @@ func (s *Sawchain) checkResourceVersion(ctx context.Context, obj client.Object,
 		return err
 	}
 	actualResourceVersion := obj.GetResourceVersion()
-	if actualResourceVersion < minResourceVersion {
+	if util.CompareResourceVersions(actualResourceVersion, minResourceVersion) < 0 {
 		return fmt.Errorf("%s: insufficient resource version: expected at least %s but got %s",
 			s.id(obj), minResourceVersion, actualResourceVersion)
 	}
@@ -139,9 +454,36 @@ func (s *Sawchain) checkResourceVersionF(ctx context.Context, obj client.Object,
 	return func() error { return s.checkResourceVersion(ctx, obj, minResourceVersion) }
 }
 
+func (s *Sawchain) checkObservedGeneration(ctx context.Context, obj client.Object, path string) error {
+	if err := s.get(ctx, obj); err != nil {
+		return err
+	}
+	generation := obj.GetGeneration()
+	if generation == 0 {
+		// Resource does not track generations
+		return nil
+	}
+	observedGeneration, found, err := util.GetObservedGeneration(obj, path)
+	if err != nil {
+		return fmt.Errorf("%s: %v", s.id(obj), err)
+	}
+	if !found {
+		return fmt.Errorf("%s: observed generation not found at %s", s.id(obj), path)
+	}
+	if observedGeneration < generation {
+		return fmt.Errorf("%s: insufficient observed generation at %s: expected at least %d but got %d",
+			s.id(obj), path, generation, observedGeneration)
+	}
+	return nil
+}
+
 func (s *Sawchain) checkNotFound(ctx context.Context, obj client.Object) error {
 	err := s.get(ctx, obj)
 	if err == nil {
+		if finalizers := obj.GetFinalizers(); obj.GetDeletionTimestamp() != nil && len(finalizers) > 0 {
+			return fmt.Errorf("%s: expected resource not to be found, but deletion is blocked by finalizers: %s",
+				s.id(obj), strings.Join(finalizers, ", "))
+		}
 		return fmt.Errorf("%s: expected resource not to be found", s.id(obj))
 	}
 	if !apierrors.IsNotFound(err) {
@@ -154,144 +496,1654 @@ func (s *Sawchain) checkNotFoundF(ctx context.Context, obj client.Object) func()
 	return func() error { return s.checkNotFound(ctx, obj) }
 }
 
-// CREATE/UPDATE/DELETE
+func (s *Sawchain) checkNamespaceTerminated(ctx context.Context, namespace *corev1.Namespace) error {
+	err := s.get(ctx, namespace)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if namespace.Status.Phase != corev1.NamespaceTerminating {
+		return fmt.Errorf("%s: expected namespace to be terminating, but phase is %q", s.id(namespace), namespace.Status.Phase)
+	}
+	return nil
+}
 
-// Create creates resources with objects, a manifest, or a Chainsaw template, and ensures client Get
-// operations for all resources succeed within a configurable duration before returning.
-//
-// If testing with a cached client, this ensures the client cache is synced and it is safe to make
-// assertions on the resources immediately after execution.
-//
-// Invalid input, client errors, and timeout errors will result in immediate test failure.
-//
-// # Arguments
-//
-// The following arguments may be provided in any order (unless noted otherwise) after the context:
-//
-//   - Object (client.Object): Typed or unstructured object for reading/writing the state of a single
-//     resource. If provided without a template, resource state will be read from the object for creation.
-//     If provided with a template, resource state will be read from the template and written to the object.
-//     State will be maintained in the original input format, which may require internal type conversions
-//     using the client scheme.
-//
-//   - Objects ([]client.Object): Slice of typed or unstructured objects for reading/writing the states of
-//     multiple resources. If provided without a template, resource states will be read from the objects for
-//     creation. If provided with a template, resource states will be read from the template and written to
-//     the objects. States will be maintained in the original input format, which may require internal type
-//     conversions using the client scheme.
-//
-//   - Template (string): File path or content of a static manifest or Chainsaw template containing complete
-//     resource definitions to be read for creation. If provided with an object, must contain exactly one
-//     resource definition matching the type of the object. If provided with a slice of objects, must
-//     contain resource definitions exactly matching the count, order, and types of the objects.
-//
-//   - Bindings (map[string]any): Bindings to be applied to a Chainsaw template (if provided) in addition to
-//     (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
-//     natural order.
-//
-//   - Timeout (string or time.Duration): Duration within which client Get operations for all resources
-//     should succeed after creation. If provided, must be before interval. Defaults to Sawchain's
-//     global timeout value.
-//
-//   - Interval (string or time.Duration): Polling interval for checking the resources after creation.
-//     If provided, must be after timeout. Defaults to Sawchain's global interval value.
-//
-// A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
-// may not be provided together. All other arguments are optional.
-//
-// # Examples
-//
-// Create a single resource with an object:
-//
-//	sc.Create(ctx, obj)
-//
-// Create multiple resources with objects:
-//
-//	sc.Create(ctx, []client.Object{obj1, obj2, obj3})
-//
-// Create resources with a manifest file and override duration settings:
-//
-//	sc.Create(ctx, "path/to/manifest.yaml", "10s", "2s")
-//
-// Create a single resource with a Chainsaw template and bindings:
-//
-//	sc.Create(ctx, `
-//	  apiVersion: v1
-//	  kind: ConfigMap
-//	  metadata:
-//	    name: ($name)
-//	    namespace: ($namespace)
-//	  data:
-//	    key: value
-//	`, map[string]any{"name": "test-cm", "namespace": "default"})
-//
-// Create a single resource with a Chainsaw template and save the resource's state to an object:
-//
-//	sc.Create(ctx, configMap, `
-//	  apiVersion: v1
-//	  kind: ConfigMap
-//	  metadata:
-//	    name: ($name)
-//	    namespace: ($namespace)
-//	  data:
-//	    key: value
-//	`, map[string]any{"name": "test-cm", "namespace": "default"})
-//
-// Create multiple resources with a Chainsaw template and bindings:
-//
-//	sc.Create(ctx, `
-//	  apiVersion: v1
-//	  kind: ConfigMap
-//	  metadata:
-//	    name: (join('-', [$prefix, 'cm']))
-//	    namespace: ($namespace)
-//	  data:
-//	    key: value
-//	  ---
-//	  apiVersion: v1
-//	  kind: Secret
-//	  metadata:
-//	    name: (join('-', [$prefix, 'secret']))
-//	    namespace: ($namespace)
-//	  type: Opaque
-//	  stringData:
-//	    username: admin
-//	    password: secret
-//	`, map[string]any{"prefix": "test", "namespace": "default"})
-//
-// Create multiple resources with a Chainsaw template and save the resources' states to objects:
-//
-//	sc.Create(ctx, []client.Object{configMap, secret}, `
-//	  apiVersion: v1
-//	  kind: ConfigMap
-//	  metadata:
-//	    name: (join('-', [$prefix, 'cm']))
-//	    namespace: ($namespace)
-//	  data:
-//	    key: value
-//	  ---
-//	  apiVersion: v1
-//	  kind: Secret
-//	  metadata:
-//	    name: (join('-', [$prefix, 'secret']))
-//	    namespace: ($namespace)
-//	  type: Opaque
-//	  stringData:
-//	    username: admin
-//	    password: secret
-//	`, map[string]any{"prefix": "test", "namespace": "default"})
-func (s *Sawchain) Create(ctx context.Context, args ...interface{}) {
-	s.t.Helper()
+func (s *Sawchain) checkNamespaceTerminatedF(ctx context.Context, namespace *corev1.Namespace) func() error {
+	return func() error { return s.checkNamespaceTerminated(ctx, namespace) }
+}
 
-	// Parse options
-	opts, err := options.ParseAndRequireEventual(&s.opts, args...)
-	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
-	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+func (s *Sawchain) apply(ctx context.Context, obj *unstructured.Unstructured, opts *options.Options) error {
+	// Apply configurations must not carry managed fields, and a stale
+	// resource version would turn the apply into a conditional update
+	obj.SetManagedFields(nil)
+	obj.SetResourceVersion("")
+	patchOpts := append([]client.PatchOption{client.FieldOwner(DefaultFieldManager)},
+		util.FilterByType[client.PatchOption](opts.ClientOptions)...)
+	return s.c.Patch(ctx, obj, client.Apply, patchOpts...)
+}
 
-	if len(opts.Template) > 0 {
-		// Render template
-		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
-		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+func (s *Sawchain) update(ctx context.Context, obj client.Object, opts *options.Options) error {
+	switch opts.Subresource {
+	case "":
+		return s.c.Update(ctx, obj, util.FilterByType[client.UpdateOption](opts.ClientOptions)...)
+	case string(ScaleSubresource):
+		return s.updateScale(ctx, obj, opts)
+	default:
+		return s.c.SubResource(opts.Subresource).Update(ctx, obj,
+			util.FilterByType[client.SubResourceUpdateOption](opts.ClientOptions)...)
+	}
+}
+
+func (s *Sawchain) patch(ctx context.Context, obj *unstructured.Unstructured, patch client.Patch, opts *options.Options) error {
+	if opts.Subresource == "" {
+		return s.c.Patch(ctx, obj, patch, util.FilterByType[client.PatchOption](opts.ClientOptions)...)
+	}
+	subresourceOpts := util.FilterByType[client.SubResourcePatchOption](opts.ClientOptions)
+	if opts.Subresource != string(ScaleSubresource) {
+		return s.c.SubResource(opts.Subresource).Patch(ctx, obj, patch, subresourceOpts...)
+	}
+	parent := s.scaleParent(obj)
+	scale := newScale(parent)
+	subresourceOpts = append(subresourceOpts, client.WithSubResourceBody(scale))
+	if err := s.c.SubResource(opts.Subresource).Patch(ctx, parent, patch, subresourceOpts...); err != nil {
+		return err
+	}
+	// The Scale only carries replicas, so read the rest of the resource state
+	resourceVersion := scale.GetResourceVersion()
+	if err := s.get(ctx, obj); err != nil {
+		return err
+	}
+	unstructuredScale, err := util.UnstructuredFromObject(s.c, scale)
+	if err != nil {
+		return err
+	}
+	for _, field := range []string{"spec", "status"} {
+		replicas, found, err := unstructured.NestedFieldNoCopy(unstructuredScale.Object, field, "replicas")
+		if err != nil {
+			return err
+		} else if !found {
+			continue
+		}
+		if err := unstructured.SetNestedField(obj.Object, replicas, field, "replicas"); err != nil {
+			return err
+		}
+	}
+	obj.SetResourceVersion(resourceVersion)
+	return nil
+}
+
+func (s *Sawchain) read(ctx context.Context, obj client.Object, subresource string) error {
+	switch subresource {
+	case "":
+		return s.get(ctx, obj)
+	case string(ScaleSubresource):
+		scale, err := s.getScale(ctx, obj)
+		if err != nil {
+			return err
+		}
+		unstructuredScale, err := util.UnstructuredFromObject(s.c, scale)
+		if err != nil {
+			return err
+		}
+		return util.CopyUnstructuredToObject(s.c, unstructuredScale, obj)
+	default:
+		return s.c.SubResource(subresource).Get(ctx, obj, obj)
+	}
+}
+
+// requireScaleObjects fails unless the objects that a scale subresource read saves to are Scales,
+// since the scale of a resource is never written into the resource itself.
+func (s *Sawchain) requireScaleObjects(opts *options.Options) {
+	objs := opts.Objects
+	if opts.Object != nil {
+		objs = []client.Object{opts.Object}
+	}
+	for _, obj := range objs {
+		s.g.Expect(obj).To(gomega.BeAssignableToTypeOf(&autoscalingv1.Scale{}), errScaleWrongObject)
+	}
+}
+
+// scaleParent returns the typed form of the object if the client scheme supports it,
+// since typed clients (including fake clients) only accept typed Scale objects.
+func (s *Sawchain) scaleParent(obj client.Object) client.Object {
+	if unstructuredObj, ok := obj.(*unstructured.Unstructured); ok {
+		if typedObj, err := util.TypedFromUnstructured(s.c, *unstructuredObj); err == nil {
+			return typedObj
+		}
+	}
+	return obj
+}
+
+// typedOrUnstructured converts the unstructured object to a typed object if the client
+// scheme recognizes its GroupVersionKind, and otherwise returns the unstructured object as is.
+func (s *Sawchain) typedOrUnstructured(obj unstructured.Unstructured) (client.Object, error) {
+	if scheme := s.c.Scheme(); scheme != nil && !scheme.Recognizes(obj.GroupVersionKind()) {
+		return &obj, nil
+	}
+	return util.TypedFromUnstructured(s.c, obj)
+}
+
+func newScale(parent client.Object) client.Object {
+	if util.IsUnstructured(parent) {
+		scale := &unstructured.Unstructured{}
+		scale.SetGroupVersionKind(autoscalingv1.SchemeGroupVersion.WithKind("Scale"))
+		return scale
+	}
+	return &autoscalingv1.Scale{}
+}
+
+func (s *Sawchain) getScale(ctx context.Context, obj client.Object) (client.Object, error) {
+	parent := s.scaleParent(obj)
+	scale := newScale(parent)
+	if err := s.c.SubResource(string(ScaleSubresource)).Get(ctx, parent, scale); err != nil {
+		return nil, err
+	}
+	return scale, nil
+}
+
+func (s *Sawchain) updateScale(ctx context.Context, obj client.Object, opts *options.Options) error {
+	unstructuredObj, err := util.UnstructuredFromObject(s.c, obj)
+	if err != nil {
+		return err
+	}
+	replicas, found, err := unstructured.NestedFieldNoCopy(unstructuredObj.Object, "spec", "replicas")
+	if err != nil {
+		return err
+	} else if !found {
+		return fmt.Errorf("%s: %s", s.id(obj), errScaleNoReplicas)
+	}
+	parent := s.scaleParent(obj)
+	scale := newScale(parent)
+	scaleContent := map[string]any{
+		"apiVersion": autoscalingv1.SchemeGroupVersion.String(),
+		"kind":       "Scale",
+		"metadata": map[string]any{
+			"name":      obj.GetName(),
+			"namespace": obj.GetNamespace(),
+		},
+		"spec": map[string]any{"replicas": replicas},
+	}
+	if unstructuredScale, ok := scale.(*unstructured.Unstructured); ok {
+		unstructuredScale.Object = scaleContent
+	} else if err := runtime.DefaultUnstructuredConverter.FromUnstructured(scaleContent, scale); err != nil {
+		return err
+	}
+	subresourceOpts := append(util.FilterByType[client.SubResourceUpdateOption](opts.ClientOptions),
+		client.WithSubResourceBody(scale))
+	if err := s.c.SubResource(string(ScaleSubresource)).Update(ctx, parent, subresourceOpts...); err != nil {
+		return err
+	}
+	// API servers write the result to the Scale, while fake clients write it to the parent
+	resourceVersion := scale.GetResourceVersion()
+	if resourceVersion == "" {
+		resourceVersion = parent.GetResourceVersion()
+	}
+	obj.SetResourceVersion(resourceVersion)
+	return nil
+}
+
+// mergeOntoLive fetches the live state of the resource identified by the object and replaces the object's
+// content with the live state deep merged with the object's content.
+func (s *Sawchain) mergeOntoLive(ctx context.Context, obj *unstructured.Unstructured) error {
+	live, err := util.UnstructuredRefFromObject(obj, s.c.Scheme())
+	if err != nil {
+		return err
+	}
+	if err := s.get(ctx, &live); err != nil {
+		return err
+	}
+	obj.Object = util.MergeMapsDeep(live.Object, obj.Object)
+	return nil
+}
+
+// applyMutation applies the mutation function to the object, converting it to the format expected
+// by the mutation function (that of the provided object, or typed if possible) and back.
+func (s *Sawchain) applyMutation(obj *unstructured.Unstructured, opts *options.Options) error {
+	target := opts.Object
+	if target == nil {
+		if typedObj, err := util.TypedFromUnstructured(s.c, *obj); err == nil {
+			target = typedObj
+		} else {
+			target = &unstructured.Unstructured{}
+		}
+	}
+	target = target.DeepCopyObject().(client.Object)
+	if err := util.CopyUnstructuredToObject(s.c, *obj, target); err != nil {
+		return err
+	}
+	if err := opts.Mutation(target); err != nil {
+		return err
+	}
+	mutated, err := util.UnstructuredFromObject(s.c, target)
+	if err != nil {
+		return err
+	}
+	*obj = mutated
+	return nil
+}
+
+// isDryRun returns whether the options request server-side dry-run (e.g. client.DryRunAll or an options
+// struct like client.CreateOptions with DryRun set), in which case changes are not persisted and there is
+// nothing to wait for.
+func isDryRun(opts *options.Options) bool {
+	dryRuns := [][]string{
+		(&client.CreateOptions{}).ApplyOptions(util.FilterByType[client.CreateOption](opts.ClientOptions)).DryRun,
+		(&client.UpdateOptions{}).ApplyOptions(util.FilterByType[client.UpdateOption](opts.ClientOptions)).DryRun,
+		(&client.PatchOptions{}).ApplyOptions(util.FilterByType[client.PatchOption](opts.ClientOptions)).DryRun,
+		(&client.DeleteOptions{}).ApplyOptions(util.FilterByType[client.DeleteOption](opts.ClientOptions)).DryRun,
+	}
+	for _, dryRun := range dryRuns {
+		if slices.Contains(dryRun, metav1.DryRunAll) {
+			return true
+		}
+	}
+	return false
+}
+
+// Dependency ranks determine the order in which resources are created (ascending) and deleted (descending).
+const (
+	rankNamespace = iota
+	rankCRD
+	rankOther
+)
+
+var crdGroupKind = schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}
+
+func (s *Sawchain) dependencyRank(obj client.Object) int {
+	gvk, err := util.GetGroupVersionKind(obj, s.c.Scheme())
+	if err != nil {
+		return rankOther
+	}
+	switch gvk.GroupKind() {
+	case schema.GroupKind{Kind: "Namespace"}:
+		return rankNamespace
+	case crdGroupKind:
+		return rankCRD
+	default:
+		return rankOther
+	}
+}
+
+// creationOrder returns the indices of the objects sorted by dependency rank, preserving input order
+// within each rank.
+func (s *Sawchain) creationOrder(objs []client.Object) []int {
+	order := make([]int, len(objs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return s.dependencyRank(objs[order[a]]) < s.dependencyRank(objs[order[b]])
+	})
+	return order
+}
+
+// deletionOrder returns the reverse of the creation order.
+func (s *Sawchain) deletionOrder(objs []client.Object) []int {
+	order := s.creationOrder(objs)
+	slices.Reverse(order)
+	return order
+}
+
+// rankBatches splits the given order into consecutive runs of indices with the same dependency rank.
+func (s *Sawchain) rankBatches(objs []client.Object, order []int) [][]int {
+	var batches [][]int
+	for start := 0; start < len(order); {
+		rank := s.dependencyRank(objs[order[start]])
+		end := start + 1
+		for end < len(order) && s.dependencyRank(objs[order[end]]) == rank {
+			end++
+		}
+		batches = append(batches, order[start:end])
+		start = end
+	}
+	return batches
+}
+
+// createInOrder creates resources in dependency order, waiting for created CRDs to be established
+// before creating resources that may depend on them. If onExists is not nil, it is called instead of
+// failing for resources that already exist. Returns whether each resource was created.
+func (s *Sawchain) createInOrder(
+	ctx context.Context,
+	objs []client.Object,
+	opts *options.Options,
+	failureMessage string,
+	onExists func(obj client.Object) error,
+) []bool {
+	s.t.Helper()
+	createOpts := util.FilterByType[client.CreateOption](opts.ClientOptions)
+	created := make([]bool, len(objs))
+	for _, batch := range s.rankBatches(objs, s.creationOrder(objs)) {
+		// Create resources of the same rank concurrently
+		err := util.ForEach(opts.Concurrency, len(batch), func(j int) error {
+			i := batch[j]
+			err := s.c.Create(ctx, objs[i], createOpts...)
+			if err == nil {
+				created[i] = true
+			} else if onExists != nil && apierrors.IsAlreadyExists(err) {
+				return onExists(objs[i])
+			}
+			return err
+		})
+		for _, i := range batch {
+			if created[i] {
+				s.deleteOnCleanup(ctx, objs[i], opts)
+				s.recordGeneratedName(objs[i], opts)
+			}
+		}
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), failureMessage)
+		if s.dependencyRank(objs[batch[0]]) == rankCRD {
+			crds := make([]client.Object, len(batch))
+			for j, i := range batch {
+				crds[j] = objs[i]
+			}
+			s.waitForCRDs(ctx, crds, opts)
+		}
+	}
+	return created
+}
+
+// deleteInOrder deletes resources in the given order (which must be a deletion order), deleting resources
+// of the same dependency rank concurrently. Resources for which ignoreNotFound is true (if provided) may
+// already be gone.
+func (s *Sawchain) deleteInOrder(
+	ctx context.Context,
+	objs []client.Object,
+	order []int,
+	ignoreNotFound []bool,
+	opts *options.Options,
+	failureMessage string,
+) {
+	s.t.Helper()
+	deleteOpts := util.FilterByType[client.DeleteOption](opts.ClientOptions)
+	for _, batch := range s.rankBatches(objs, order) {
+		err := util.ForEach(opts.Concurrency, len(batch), func(j int) error {
+			i := batch[j]
+			err := s.c.Delete(ctx, objs[i], deleteOpts...)
+			if ignoreNotFound != nil && ignoreNotFound[i] {
+				return client.IgnoreNotFound(err)
+			}
+			return err
+		})
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), failureMessage)
+	}
+}
+
+// checkCRDEstablished checks if a CRD is established and its kind is known to the client's RESTMapper,
+// resetting the RESTMapper (if supported) when it is not.
+func (s *Sawchain) checkCRDEstablished(ctx context.Context, crd client.Object) error {
+	live, err := util.UnstructuredRefFromObject(crd, s.c.Scheme())
+	if err != nil {
+		return err
+	}
+	if err := s.get(ctx, &live); err != nil {
+		return err
+	}
+	conditions, _, _ := unstructured.NestedSlice(live.Object, "status", "conditions")
+	established := false
+	for _, condition := range conditions {
+		if conditionMap, ok := condition.(map[string]any); ok &&
+			conditionMap["type"] == "Established" && conditionMap["status"] == "True" {
+			established = true
+		}
+	}
+	if !established {
+		return fmt.Errorf("%s: not established", s.id(crd))
+	}
+	group, _, _ := unstructured.NestedString(live.Object, "spec", "group")
+	kind, _, _ := unstructured.NestedString(live.Object, "spec", "names", "kind")
+	specVersions, _, _ := unstructured.NestedSlice(live.Object, "spec", "versions")
+	var versions []string
+	for _, version := range specVersions {
+		if versionMap, ok := version.(map[string]any); ok && versionMap["served"] == true {
+			if name, ok := versionMap["name"].(string); ok {
+				versions = append(versions, name)
+			}
+		}
+	}
+	if _, err := s.c.RESTMapper().RESTMapping(schema.GroupKind{Group: group, Kind: kind}, versions...); err != nil {
+		meta.MaybeResetRESTMapper(s.c.RESTMapper())
+		return fmt.Errorf("%s: %w", s.id(crd), err)
+	}
+	return nil
+}
+
+func (s *Sawchain) waitForCRDs(ctx context.Context, crds []client.Object, opts *options.Options) {
+	s.t.Helper()
+	if len(crds) == 0 || isDryRun(opts) {
+		return
+	}
+	checkAll := func() error {
+		for _, crd := range crds {
+			if err := s.checkCRDEstablished(ctx, crd); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	s.g.Eventually(checkAll, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errCRDNotEstablished)
+}
+
+// writeTargets returns the objects to write for operations that expect rejection: the objects rendered
+// from the template (copied into the provided object(s) if any), or the provided object(s) otherwise.
+func (s *Sawchain) writeTargets(ctx context.Context, opts *options.Options) []client.Object {
+	s.t.Helper()
+	objs := opts.Objects
+	if opts.Object != nil {
+		objs = []client.Object{opts.Object}
+	}
+	if len(opts.Template) == 0 {
+		return objs
+	}
+
+	// Render template
+	unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+	s.defaultNamespace(objectRefs(unstructuredObjs)...)
+
+	// Validate objects length
+	if opts.Object != nil {
+		s.g.Expect(unstructuredObjs).To(gomega.HaveLen(1), errObjectInsufficient)
+	} else if opts.Objects != nil {
+		s.g.Expect(opts.Objects).To(gomega.HaveLen(len(unstructuredObjs)), errObjectsWrongLength)
+	} else {
+		return objectRefs(unstructuredObjs)
+	}
+
+	// Copy rendered state to objects
+	for i := range objs {
+		s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObjs[i], objs[i])).To(gomega.Succeed(), errFailedSave)
+	}
+	return objs
+}
+
+func (s *Sawchain) waitForCacheSync(check func() error, opts *options.Options) {
+	s.t.Helper()
+	if isDryRun(opts) {
+		return
+	}
+	s.g.Eventually(check, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errCacheNotSynced)
+}
+
+// checkEachF returns a function that runs check for each index in [0, n), using up to opts.Concurrency
+// concurrent goroutines. Indices that have passed are skipped in later calls, so polling the returned
+// function only revisits resources that are still pending.
+func checkEachF(n int, opts *options.Options, check func(i int) error) func() error {
+	passed := make([]bool, n)
+	return func() error {
+		return util.ForEach(opts.Concurrency, n, func(i int) error {
+			if passed[i] {
+				return nil
+			}
+			if err := check(i); err != nil {
+				return err
+			}
+			passed[i] = true
+			return nil
+		})
+	}
+}
+
+// updateEach updates the given resources, using up to opts.Concurrency concurrent goroutines.
+func (s *Sawchain) updateEach(ctx context.Context, objs []client.Object, opts *options.Options) error {
+	return util.ForEach(opts.Concurrency, len(objs), func(i int) error {
+		return s.update(ctx, objs[i], opts)
+	})
+}
+
+// updateExisting updates an existing resource, using its live resource version unless one is set.
+func (s *Sawchain) updateExisting(ctx context.Context, obj client.Object, opts *options.Options) error {
+	if obj.GetResourceVersion() == "" {
+		live, err := util.UnstructuredRefFromObject(obj, s.c.Scheme())
+		if err != nil {
+			return err
+		}
+		if err := s.get(ctx, &live); err != nil {
+			return err
+		}
+		obj.SetResourceVersion(live.GetResourceVersion())
+	}
+	return s.update(ctx, obj, opts)
+}
+
+// getEachF returns a function that gets the given resources, using up to opts.Concurrency concurrent
+// goroutines and skipping resources that have already been found.
+func (s *Sawchain) getEachF(ctx context.Context, objs []client.Object, opts *options.Options) func() error {
+	return checkEachF(len(objs), opts, func(i int) error {
+		return s.get(ctx, objs[i])
+	})
+}
+
+func (s *Sawchain) waitForResourceVersions(ctx context.Context, objs []client.Object, opts *options.Options) {
+	s.t.Helper()
+	minResourceVersions := make([]string, len(objs))
+	for i := range objs {
+		minResourceVersions[i] = objs[i].GetResourceVersion()
+	}
+	checkAll := checkEachF(len(objs), opts, func(i int) error {
+		return s.checkResourceVersion(ctx, objs[i], minResourceVersions[i])
+	})
+	s.waitForCacheSync(checkAll, opts)
+}
+
+func (s *Sawchain) waitForReconciliation(ctx context.Context, objs []client.Object, opts *options.Options) {
+	s.t.Helper()
+	if opts.ObservedGenerationPath == "" || isDryRun(opts) {
+		return
+	}
+	checkAll := checkEachF(len(objs), opts, func(i int) error {
+		return s.checkObservedGeneration(ctx, objs[i], opts.ObservedGenerationPath)
+	})
+	s.g.Eventually(checkAll, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errNotReconciled)
+}
+
+// listTarget returns the list to write and the list options for a list operation.
+func (s *Sawchain) listTarget(ctx context.Context, opts *options.Options) (client.ObjectList, []client.ListOption) {
+	s.t.Helper()
+	list := opts.ObjectList
+	var listOpts []client.ListOption
+	if len(opts.Template) > 0 {
+		// Render template
+		unstructuredObj, err := chainsaw.RenderTemplateSingle(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+		s.defaultNamespace(&unstructuredObj)
+		s.g.Expect(unstructuredObj.GetName()).To(gomega.BeEmpty(), errListTemplateNamed)
+
+		// Select resources of the template's kind, namespace, and labels
+		if unstructuredObj.GetNamespace() != "" {
+			listOpts = append(listOpts, client.InNamespace(unstructuredObj.GetNamespace()))
+		}
+		if len(unstructuredObj.GetLabels()) != 0 {
+			listOpts = append(listOpts, client.MatchingLabels(unstructuredObj.GetLabels()))
+		}
+		listGVK := unstructuredObj.GroupVersionKind()
+		listGVK.Kind += "List"
+		if list == nil {
+			list = s.newList(listGVK)
+		} else if unstructuredList, ok := list.(*unstructured.UnstructuredList); ok && unstructuredList.GetKind() == "" {
+			unstructuredList.SetGroupVersionKind(listGVK)
+		} else {
+			gvk, err := apiutil.GVKForObject(list, s.c.Scheme())
+			s.g.Expect(err).NotTo(gomega.HaveOccurred(), errListTypeMismatch)
+			s.g.Expect(gvk).To(gomega.Equal(listGVK), errListTypeMismatch)
+		}
+	}
+	return list, append(listOpts, util.FilterByType[client.ListOption](opts.ClientOptions)...)
+}
+
+// newList returns a typed list for the given list GVK if registered in the client scheme,
+// or an unstructured list otherwise.
+func (s *Sawchain) newList(gvk schema.GroupVersionKind) client.ObjectList {
+	if obj, err := s.c.Scheme().New(gvk); err == nil {
+		if list, ok := obj.(client.ObjectList); ok {
+			return list
+		}
+	}
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk)
+	return list
+}
+
+func listFailureMessage(opts *options.Options) string {
+	if len(opts.Template) > 0 {
+		return errFailedListWithTemplate
+	}
+	return errFailedListWithList
+}
+
+// checkF returns a function that checks all template documents and saves the matches to the object
+// or objects (if any).
+func (s *Sawchain) checkF(ctx context.Context, opts *options.Options) func() error {
+	// Construct bindings
+	bindings := chainsaw.BindingsFromMap(opts.Bindings)
+
+	// Split documents
+	documents, err := chainsaw.SplitDocuments(opts.Template)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+
+	// Validate objects length
+	if opts.Object != nil {
+		s.g.Expect(documents).To(gomega.HaveLen(1), errObjectInsufficient)
+	} else if opts.Objects != nil {
+		s.g.Expect(opts.Objects).To(gomega.HaveLen(len(documents)), errObjectsWrongLength)
+	}
+
+	// Render expectations
+	expectations, renderErrs := renderExpectations(ctx, documents, bindings)
+
+	return func() error {
+		// Execute checks
+		matches, err := s.checkDocuments(ctx, expectations, renderErrs, bindings)
+		if err != nil {
+			return err
+		}
+
+		// Save matches
+		if opts.Object != nil {
+			s.g.Expect(util.CopyUnstructuredToObject(s.c, matches[0], opts.Object)).To(gomega.Succeed(), errFailedSave)
+		} else if opts.Objects != nil {
+			for i, match := range matches {
+				s.g.Expect(util.CopyUnstructuredToObject(s.c, match, opts.Objects[i])).To(gomega.Succeed(), errFailedSave)
+			}
+		}
+
+		return nil
+	}
+}
+
+// renderExpectations renders and compiles the expected resource of each document once, so that checks
+// polled repeatedly only re-evaluate the assertions. Render errors are returned per document.
+func renderExpectations(
+	ctx context.Context,
+	documents []string,
+	bindings chainsaw.Bindings,
+) ([]chainsaw.Expectation, []error) {
+	expectations := make([]chainsaw.Expectation, len(documents))
+	renderErrs := make([]error, len(documents))
+	for i, document := range documents {
+		expectations[i], renderErrs[i] = chainsaw.RenderExpectation(ctx, document, bindings)
+	}
+	return expectations, renderErrs
+}
+
+// checkDocuments checks all document expectations, returning the matches or an error joining the failures
+// of all documents that don't match (or failed to render). Failures of multi-document templates identify
+// their documents.
+func (s *Sawchain) checkDocuments(
+	ctx context.Context,
+	expectations []chainsaw.Expectation,
+	renderErrs []error,
+	bindings chainsaw.Bindings,
+) ([]unstructured.Unstructured, error) {
+	matches := make([]unstructured.Unstructured, len(expectations))
+	var errs []error
+	for i, expectation := range expectations {
+		if renderErrs[i] != nil {
+			if len(expectations) == 1 {
+				errs = append(errs, renderErrs[i])
+			} else {
+				errs = append(errs, fmt.Errorf("document %d: %w", i, renderErrs[i]))
+			}
+			continue
+		}
+		match, err := chainsaw.CheckExpectation(s.c, ctx, expectation, bindings)
+		if err == nil {
+			matches[i] = match
+		} else if len(expectations) == 1 {
+			errs = append(errs, err)
+		} else {
+			errs = append(errs, fmt.Errorf("document %d: %s: %w", i, s.id(&expectation.Resource), err))
+		}
+	}
+	return matches, errors.Join(errs...)
+}
+
+// checkAllF returns a function that returns all resources matching the template, or an error if the
+// number of matches is outside the bounds (at least one by default). Errors for too few matches include
+// the candidate mismatches, and errors for too many matches identify the matches. The template is only
+// rendered once.
+func (s *Sawchain) checkAllF(ctx context.Context, opts *options.Options) func() ([]client.Object, error) {
+	// Construct bindings
+	bindings := chainsaw.BindingsFromMap(opts.Bindings)
+
+	// Render expectation
+	expectation, renderErr := chainsaw.RenderExpectation(ctx, opts.Template, bindings)
+
+	return func() ([]client.Object, error) {
+		if renderErr != nil {
+			return nil, renderErr
+		}
+		return s.checkAll(ctx, expectation, bindings, opts)
+	}
+}
+
+func (s *Sawchain) checkAll(
+	ctx context.Context,
+	expectation chainsaw.Expectation,
+	bindings chainsaw.Bindings,
+	opts *options.Options,
+) ([]client.Object, error) {
+	// Execute check
+	matches, mismatches, err := chainsaw.CheckAllExpectation(s.c, ctx, expectation, bindings)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert matches
+	objs := make([]client.Object, len(matches))
+	for i, match := range matches {
+		obj, err := s.typedOrUnstructured(match)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", errFailedConvert, err)
+		}
+		objs[i] = obj
+	}
+
+	// Validate match count
+	count := options.MatchCount{Min: 1, Unbounded: true}
+	if opts.MatchCount != nil {
+		count = *opts.MatchCount
+	}
+	if count.Allows(len(objs)) {
+		return objs, nil
+	}
+	countErr := fmt.Errorf(errWrongMatchCount, count, len(objs))
+	if len(objs) < count.Min {
+		return objs, errors.Join(append([]error{countErr}, mismatches...)...)
+	}
+	ids := make([]string, len(objs))
+	for i, obj := range objs {
+		ids[i] = s.id(obj)
+	}
+	return objs, fmt.Errorf("%w: %s", countErr, strings.Join(ids, ", "))
+}
+
+// checkAbsent returns an error joining the failures of all documents that match resources in the cluster
+// (identifying the matches) or that can't be checked. Failures of multi-document templates identify
+// their documents.
+func (s *Sawchain) checkAbsent(ctx context.Context, expectations []chainsaw.Expectation, bindings chainsaw.Bindings) error {
+	var errs []error
+	for i, expectation := range expectations {
+		matches, _, err := chainsaw.CheckAllExpectation(s.c, ctx, expectation, bindings)
+		if err == nil && len(matches) > 0 {
+			ids := make([]string, len(matches))
+			for j := range matches {
+				ids[j] = s.id(&matches[j])
+			}
+			err = fmt.Errorf(errUnexpectedMatch+": %s", len(matches), strings.Join(ids, ", "))
+		}
+		if err == nil {
+			continue
+		} else if len(expectations) == 1 {
+			errs = append(errs, err)
+		} else {
+			errs = append(errs, fmt.Errorf("document %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func objectRefs(unstructuredObjs []unstructured.Unstructured) []client.Object {
+	objs := make([]client.Object, len(unstructuredObjs))
+	for i := range unstructuredObjs {
+		objs[i] = &unstructuredObjs[i]
+	}
+	return objs
+}
+
+func (s *Sawchain) convertObjects(opts *options.Options) []unstructured.Unstructured {
+	s.t.Helper()
+	objs := opts.Objects
+	if opts.Object != nil {
+		objs = []client.Object{opts.Object}
+	}
+	unstructuredObjs := make([]unstructured.Unstructured, len(objs))
+	for i, obj := range objs {
+		unstructuredObj, err := util.UnstructuredFromObject(s.c, obj)
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedConvertToUnstructured)
+		// Copy to avoid modifying unstructured input by reference
+		unstructuredObjs[i] = *unstructuredObj.DeepCopy()
+	}
+	return unstructuredObjs
+}
+
+func (s *Sawchain) saveObjects(unstructuredObjs []unstructured.Unstructured, opts *options.Options) {
+	s.t.Helper()
+	if opts.Object != nil {
+		s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObjs[0], opts.Object)).To(gomega.Succeed(), errFailedSave)
+	} else if opts.Objects != nil {
+		for i, unstructuredObj := range unstructuredObjs {
+			s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObj, opts.Objects[i])).To(gomega.Succeed(), errFailedSave)
+		}
+	}
+}
+
+func (s *Sawchain) waitForDeletion(ctx context.Context, objs []client.Object, opts *options.Options) {
+	s.t.Helper()
+	if isDryRun(opts) {
+		return
+	}
+	checkAll := checkEachF(len(objs), opts, func(i int) error {
+		return s.checkNotFound(ctx, objs[i])
+	})
+	if opts.RemoveFinalizers {
+		// Wait without failing to give finalizers a chance to be handled normally
+		g := gomega.NewGomega(func(string, ...int) {})
+		if g.Eventually(checkAll, opts.Timeout, opts.Interval).Should(gomega.Succeed()) {
+			return
+		}
+		patch := client.RawPatch(apitypes.MergePatchType, []byte(`{"metadata":{"finalizers":null}}`))
+		for _, obj := range objs {
+			s.g.Expect(client.IgnoreNotFound(s.c.Patch(ctx, obj, patch))).To(gomega.Succeed(), errFailedRemoveFinalizers)
+		}
+	}
+	s.waitForCacheSync(checkAll, opts)
+}
+
+// waitForNoCandidates waits until no resources match the kind, namespace, and labels of the given unnamed
+// template documents, deleting matching resources created since they were first listed.
+func (s *Sawchain) waitForNoCandidates(ctx context.Context, docs []client.Object, opts *options.Options) {
+	s.t.Helper()
+	if len(docs) == 0 || isDryRun(opts) {
+		return
+	}
+	deleteOpts := util.FilterByType[client.DeleteOption](opts.ClientOptions)
+	checkAll := func() error {
+		for _, doc := range docs {
+			candidates, err := chainsaw.ListCandidates(s.c, ctx, doc)
+			if err != nil {
+				return err
+			}
+			for i := range candidates {
+				if candidates[i].GetDeletionTimestamp() != nil {
+					continue
+				}
+				if err := client.IgnoreNotFound(s.c.Delete(ctx, &candidates[i], deleteOpts...)); err != nil {
+					return err
+				}
+			}
+			if len(candidates) > 0 {
+				return fmt.Errorf("expected no matching resources but found %d", len(candidates))
+			}
+		}
+		return nil
+	}
+	s.waitForCacheSync(checkAll, opts)
+}
+
+func (s *Sawchain) deleteOnCleanup(ctx context.Context, obj client.Object, opts *options.Options) {
+	if opts.SkipCleanup || isDryRun(opts) {
+		return
+	}
+	// Decouple from caller state, which may be modified or canceled before cleanup
+	obj = obj.DeepCopyObject().(client.Object)
+	ctx = context.WithoutCancel(ctx)
+	cleanupOpts := &options.Options{
+		Timeout:          opts.Timeout,
+		Interval:         opts.Interval,
+		RemoveFinalizers: s.opts.RemoveFinalizers,
+	}
+	s.t.Cleanup(func() {
+		s.t.Helper()
+		s.g.Expect(client.IgnoreNotFound(s.c.Delete(ctx, obj))).To(gomega.Succeed(), errFailedCleanup)
+		s.waitForDeletion(ctx, []client.Object{obj}, cleanupOpts)
+	})
+}
+
+// CREATE/UPDATE/DELETE
+
+// Create creates resources with objects, a manifest, or a Chainsaw template, and ensures client Get
+// operations for all resources succeed within a configurable duration before returning.
+//
+// If testing with a cached client, this ensures the client cache is synced and it is safe to make
+// assertions on the resources immediately after execution.
+//
+// Namespaces are created first, followed by CRDs, followed by all other resources (preserving the given
+// order within each group). Before creating resources after CRDs, Sawchain waits for the CRDs to be
+// established and for the client's RESTMapper to recognize their kinds.
+//
+// Resources may be defined with metadata.generateName instead of metadata.name. Server-assigned names are
+// written back to the provided object(s) and recorded in the global GeneratedNamesBinding binding for use
+// in later template operations.
+//
+// Created resources are automatically deleted in reverse creation order when the test ends (using
+// testing.TB.Cleanup, which Ginkgo maps to DeferCleanup), and the cleanup waits for client Get operations
+// to reflect the deletion like Delete does. Resources already deleted by the test are ignored.
+//
+// Invalid input, client errors, and timeout errors will result in immediate test failure.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Object (client.Object): Typed or unstructured object for reading/writing the state of a single
+//     resource. If provided without a template, resource state will be read from the object for creation.
+//     If provided with a template, resource state will be read from the template and written to the object.
+//     State will be maintained in the original input format, which may require internal type conversions
+//     using the client scheme.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for reading/writing the states of
+//     multiple resources. If provided without a template, resource states will be read from the objects for
+//     creation. If provided with a template, resource states will be read from the template and written to
+//     the objects. States will be maintained in the original input format, which may require internal type
+//     conversions using the client scheme.
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing complete
+//     resource definitions to be read for creation. If provided with an object, must contain exactly one
+//     resource definition matching the type of the object. If provided with a slice of objects, must
+//     contain resource definitions exactly matching the count, order, and types of the objects.
+//
+//   - Bindings (map[string]any): Bindings to be applied to a Chainsaw template (if provided) in addition to
+//     (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Client Options (client.CreateOption): Options for the create requests (e.g. client.FieldOwner).
+//
+//   - Dry Run (client.DryRunAll): Sends the requests as server-side dry runs. Nothing is persisted, waiting
+//     is skipped, and the state returned by the API server (e.g. after defaulting and admission) is written
+//     to the provided object(s).
+//
+//   - Timeout (string or time.Duration): Duration within which client Get operations for all resources
+//     should succeed after creation. If provided, must be before interval. Defaults to Sawchain's
+//     global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval for checking the resources after creation.
+//     If provided, must be after timeout. Defaults to Sawchain's global interval value.
+//
+//   - WaitForReconciliation (or WaitForReconciliationAt): Waits within the timeout for controllers to
+//     reconcile the resources (observed generation reaching the resource generation) before returning.
+//     Implied if provided to New.
+//
+//   - SkipCleanup: Disables automatic cleanup of the created resources, allowing them to outlive the
+//     test. Implied if provided to New.
+//
+//   - Concurrency: Maximum number of resources to create at a time. If greater than 1, all create errors
+//     are reported together. Defaults to Sawchain's global concurrency (1 if not set).
+//
+// A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
+// may not be provided together. All other arguments are optional.
+//
+// # Examples
+//
+// Create a single resource with an object:
+//
+//	sc.Create(ctx, obj)
+//
+// Create multiple resources with objects:
+//
+//	sc.Create(ctx, []client.Object{obj1, obj2, obj3})
+//
+// Create resources with a manifest file and override duration settings:
+//
+//	sc.Create(ctx, "path/to/manifest.yaml", "10s", "2s")
+//
+// Create a single resource with a Chainsaw template and bindings:
+//
+//	sc.Create(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  data:
+//	    key: value
+//	`, map[string]any{"name": "test-cm", "namespace": "default"})
+//
+// Create a single resource with a Chainsaw template and save the resource's state to an object:
+//
+//	sc.Create(ctx, configMap, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  data:
+//	    key: value
+//	`, map[string]any{"name": "test-cm", "namespace": "default"})
+//
+// Create multiple resources with a Chainsaw template and bindings:
+//
+//	sc.Create(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: (join('-', [$prefix, 'cm']))
+//	    namespace: ($namespace)
+//	  data:
+//	    key: value
+//	  ---
+//	  apiVersion: v1
+//	  kind: Secret
+//	  metadata:
+//	    name: (join('-', [$prefix, 'secret']))
+//	    namespace: ($namespace)
+//	  type: Opaque
+//	  stringData:
+//	    username: admin
+//	    password: secret
+//	`, map[string]any{"prefix": "test", "namespace": "default"})
+//
+// Create multiple resources with a Chainsaw template and save the resources' states to objects:
+//
+//	sc.Create(ctx, []client.Object{configMap, secret}, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: (join('-', [$prefix, 'cm']))
+//	    namespace: ($namespace)
+//	  data:
+//	    key: value
+//	  ---
+//	  apiVersion: v1
+//	  kind: Secret
+//	  metadata:
+//	    name: (join('-', [$prefix, 'secret']))
+//	    namespace: ($namespace)
+//	  type: Opaque
+//	  stringData:
+//	    username: admin
+//	    password: secret
+//	`, map[string]any{"prefix": "test", "namespace": "default"})
+//
+// Create a resource that should not be deleted when the test ends:
+//
+//	sc.Create(ctx, obj, sawchain.SkipCleanup)
+//
+// Create a resource with a generated name and refer to it in a later template operation:
+//
+//	sc.Create(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    generateName: test-cm-
+//	    namespace: default
+//	`)
+//	Expect(sc.Check(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: ($generatedNames."test-cm-")
+//	    namespace: default
+//	`)).To(Succeed())
+//
+// Create a custom resource and wait for its controller to reconcile it:
+//
+//	sc.Create(ctx, obj, sawchain.WaitForReconciliationAt("status.atProvider.observedGeneration"))
+//
+// Preview the state the API server would persist for a template (e.g. after webhook defaulting) without
+// creating the resource:
+//
+//	sc.Create(ctx, obj, "path/to/template.yaml", client.DryRunAll)
+//
+// Create many resources from a large manifest, up to 20 at a time:
+//
+//	sc.Create(ctx, "path/to/fixtures.yaml", sawchain.Concurrency(20))
+func (s *Sawchain) Create(ctx context.Context, args ...interface{}) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndRequireEventual(&s.opts, includeCreate, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
+	if len(opts.Template) > 0 {
+		// Render template
+		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+		s.defaultNamespace(objectRefs(unstructuredObjs)...)
+
+		// Validate objects length
+		if opts.Object != nil {
+			s.g.Expect(unstructuredObjs).To(gomega.HaveLen(1), errObjectInsufficient)
+		} else if opts.Objects != nil {
+			s.g.Expect(opts.Objects).To(gomega.HaveLen(len(unstructuredObjs)), errObjectsWrongLength)
+		}
+
+		// Create resources
+		s.createInOrder(ctx, objectRefs(unstructuredObjs), opts, errFailedCreateWithTemplate, nil)
+
+		// Wait for cache to sync
+		s.waitForCacheSync(s.getEachF(ctx, objectRefs(unstructuredObjs), opts), opts)
+
+		// Wait for reconciliation
+		s.waitForReconciliation(ctx, objectRefs(unstructuredObjs), opts)
+
+		// Save objects
+		if opts.Object != nil {
+			s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObjs[0], opts.Object)).To(gomega.Succeed(), errFailedSave)
+		} else if opts.Objects != nil {
+			for i, unstructuredObj := range unstructuredObjs {
+				s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObj, opts.Objects[i])).To(gomega.Succeed(), errFailedSave)
+			}
+		}
+	} else if opts.Object != nil {
+		// Create resource
+		s.createInOrder(ctx, []client.Object{opts.Object}, opts, errFailedCreateWithObject, nil)
+
+		// Wait for cache to sync
+		s.waitForCacheSync(s.getF(ctx, opts.Object), opts)
+
+		// Wait for reconciliation
+		s.waitForReconciliation(ctx, []client.Object{opts.Object}, opts)
+	} else {
+		// Create resources
+		s.createInOrder(ctx, opts.Objects, opts, errFailedCreateWithObject, nil)
+
+		// Wait for cache to sync
+		s.waitForCacheSync(s.getEachF(ctx, opts.Objects, opts), opts)
+
+		// Wait for reconciliation
+		s.waitForReconciliation(ctx, opts.Objects, opts)
+	}
+}
+
+// CreateExpectError attempts to create resources with objects, a manifest, or a Chainsaw template, and
+// returns the first error returned by the API server instead of failing the test. This is useful for
+// testing that invalid resources are rejected by validation webhooks, CEL validation rules, etc.
+//
+// Resources are created in order until one is rejected. Resources created before the rejection are
+// automatically deleted when the test ends, like with Create.
+//
+// Use the returned error with HaveStatusReason, HaveStatusCode, HaveStatusCause, or standard Gomega
+// matchers to assert the reason for the rejection.
+//
+// Invalid input and successful creation of all resources will result in immediate test failure.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Object (client.Object): Typed or unstructured object for reading/writing the state of a single
+//     resource. If provided without a template, resource state will be read from the object for creation.
+//     If provided with a template, resource state will be read from the template and written to the object
+//     before creation.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for reading/writing the states of
+//     multiple resources. If provided without a template, resource states will be read from the objects for
+//     creation. If provided with a template, resource states will be read from the template and written to
+//     the objects before creation.
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing complete
+//     resource definitions to be read for creation. If provided with an object, must contain exactly one
+//     resource definition matching the type of the object. If provided with a slice of objects, must
+//     contain resource definitions exactly matching the count, order, and types of the objects.
+//
+//   - Bindings (map[string]any): Bindings to be applied to a Chainsaw template (if provided) in addition to
+//     (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Client Options (client.CreateOption): Options for the create requests (e.g. client.DryRunAll).
+//
+// A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
+// may not be provided together. All other arguments are optional.
+//
+// # Examples
+//
+// Assert that a resource is rejected as invalid:
+//
+//	err := sc.CreateExpectError(ctx, `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
+//	  metadata:
+//	    name: test-deployment
+//	    namespace: default
+//	  spec:
+//	    replicas: -1
+//	`)
+//	Expect(err).To(sc.HaveStatusReason(metav1.StatusReasonInvalid))
+//	Expect(err).To(sc.HaveStatusCause(metav1.StatusCause{
+//		Type:  metav1.CauseTypeFieldValueInvalid,
+//		Field: "spec.replicas",
+//	}))
+//
+// Assert that a validation webhook denies a resource with a specific message:
+//
+//	err := sc.CreateExpectError(ctx, obj)
+//	Expect(err).To(sc.HaveStatusCode(http.StatusForbidden))
+//	Expect(err).To(MatchError(ContainSubstring("denied by policy")))
+func (s *Sawchain) CreateExpectError(ctx context.Context, args ...interface{}) error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndRequireImmediate(&s.opts, includeCreateExpectError, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
+	// Create resources until rejected
+	var rejection error
+	createOpts := util.FilterByType[client.CreateOption](opts.ClientOptions)
+	for _, obj := range s.writeTargets(ctx, opts) {
+		if rejection = s.c.Create(ctx, obj, createOpts...); rejection != nil {
+			break
+		}
+		s.deleteOnCleanup(ctx, obj, opts)
+	}
+	s.g.Expect(rejection).To(gomega.HaveOccurred(), errUnexpectedCreateSuccess)
+	return rejection
+}
+
+// TODO: test
+// Update updates resources with objects, a manifest, or a Chainsaw template, and ensures client Get
+// operations for all resources reflect the updates within a configurable duration before returning.
+//
+// If testing with a cached client, this ensures the client cache is synced and it is safe to make
+// assertions on the updated resources immediately after execution.
+//
+// Invalid input, client errors, and timeout errors will result in immediate test failure.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Object (client.Object): Typed or unstructured object for reading/writing the state of a single
+//     resource. If provided without a template, resource state will be read from the object for update.
+//     If provided with a template, resource state will be read from the template and written to the object.
+//     State will be maintained in the original input format, which may require internal type conversions
+//     using the client scheme.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for reading/writing the states of
+//     multiple resources. If provided without a template, resource states will be read from the objects for
+//     update. If provided with a template, resource states will be read from the template and written to
+//     the objects. States will be maintained in the original input format, which may require internal type
+//     conversions using the client scheme.
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing complete
+//     resource definitions to be read for update (or partial definitions with MergeOntoLive). If provided
+//     with an object, must contain exactly one resource definition matching the type of the object. If
+//     provided with a slice of objects, must contain resource definitions exactly matching the count,
+//     order, and types of the objects.
+//
+//   - Bindings (map[string]any): Bindings to be applied to a Chainsaw template (if provided) in addition to
+//     (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - MergeOntoLive: Fetches the live state of each resource and deep merges the rendered template onto it
+//     before updating (maps are merged, all other values are replaced), so that the template only needs
+//     the identifying metadata of each resource plus the fields to change. Ignored without a template.
+//     Unlike Mutate, conflicts are not retried. Implied if provided to New.
+//
+//   - Client Options (client.UpdateOption): Options for the update requests (e.g. client.FieldOwner).
+//
+//   - Dry Run (client.DryRunAll): Sends the requests as server-side dry runs. Nothing is persisted, waiting
+//     is skipped, and the state returned by the API server (e.g. after defaulting and admission) is written
+//     to the provided object(s).
+//
+//   - Timeout (string or time.Duration): Duration within which client Get operations for all resources
+//     should reflect the updates. If provided, must be before interval. Defaults to Sawchain's
+//     global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval for checking the resources after updating.
+//     If provided, must be after timeout. Defaults to Sawchain's global interval value.
+//
+//   - WaitForReconciliation (or WaitForReconciliationAt): Waits within the timeout for controllers to
+//     reconcile the resources (observed generation reaching the resource generation) before returning.
+//     Implied if provided to New.
+//
+//   - Subresource (StatusSubresource or ScaleSubresource): Updates the given subresource instead of the
+//     main resource. Needed to write status when the status subresource is enabled, since status changes
+//     are otherwise ignored. Scale updates only use the spec.replicas field of the resource definitions.
+//
+//   - Concurrency: Maximum number of resources to update at a time. If greater than 1, all update errors
+//     are reported together. Defaults to Sawchain's global concurrency (1 if not set).
+//
+// A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
+// may not be provided together. All other arguments are optional.
+//
+// # Examples
+//
+// Update a single resource with an object:
+//
+//	sc.UpdateResourceAndWait(ctx, obj)
+//
+// Update multiple resources with objects:
+//
+//	sc.Update(ctx, []client.Object{obj1, obj2, obj3})
+//
+// Update resources with a manifest file and override duration settings:
+//
+//	sc.Update(ctx, "path/to/manifest.yaml", "10s", "2s")
+//
+// Update a single resource with a Chainsaw template and bindings:
+//
+//	sc.UpdateResourceAndWait(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  data:
+//	    key: updated-value
+//	`, map[string]any{"name": "test-cm", "namespace": "default"})
+//
+// Update a single resource with a Chainsaw template and save the resource's updated state to an object:
+//
+//	sc.UpdateResourceAndWait(ctx, configMap, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  data:
+//	    key: updated-value
+//	`, map[string]any{"name": "test-cm", "namespace": "default"})
+//
+// Update multiple resources with a Chainsaw template and bindings:
+//
+//	sc.Update(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: (join('-', [$prefix, 'cm']))
+//	    namespace: ($namespace)
+//	  data:
+//	    key: updated-value
+//	  ---
+//	  apiVersion: v1
+//	  kind: Secret
+//	  metadata:
+//	    name: (join('-', [$prefix, 'secret']))
+//	    namespace: ($namespace)
+//	  type: Opaque
+//	  stringData:
+//	    username: admin
+//	    password: updated-secret
+//	`, map[string]any{"prefix": "test", "namespace": "default"})
+//
+// Update the status of a resource with a Chainsaw template:
+//
+//	sc.Update(ctx, sawchain.StatusSubresource, `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  status:
+//	    readyReplicas: 1
+//	`, map[string]any{"name": "test-deployment", "namespace": "default"})
+//
+// Update only the labels of a resource, preserving all other fields:
+//
+//	sc.Update(ctx, sawchain.MergeOntoLive, `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	    labels:
+//	      tier: backend
+//	`, map[string]any{"name": "test-deployment", "namespace": "default"})
+//
+// Update a resource and wait for its controller to reconcile the change before asserting on its status:
+//
+//	sc.Update(ctx, deployment, sawchain.WaitForReconciliation)
+//	Expect(deployment).To(sc.HaveStatusCondition("Available", "True"))
+//
+// Update multiple resources with a Chainsaw template and save the resources' updated states to objects:
+//
+//	sc.Update(ctx, []client.Object{configMap, secret}, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: (join('-', [$prefix, 'cm']))
+//	    namespace: ($namespace)
+//	  data:
+//	    key: updated-value
+//	  ---
+//	  apiVersion: v1
+//	  kind: Secret
+//	  metadata:
+//	    name: (join('-', [$prefix, 'secret']))
+//	    namespace: ($namespace)
+//	  type: Opaque
+//	  stringData:
+//	    username: admin
+//	    password: updated-secret
+//	`, map[string]any{"prefix": "test", "namespace": "default"})
+func (s *Sawchain) Update(ctx context.Context, args ...interface{}) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndRequireEventual(&s.opts, includeUpdate, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
+	if len(opts.Template) > 0 {
+		// Render template
+		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+		s.defaultNamespace(objectRefs(unstructuredObjs)...)
+
+		// Validate objects length
+		if opts.Object != nil {
+			s.g.Expect(unstructuredObjs).To(gomega.HaveLen(1), errObjectInsufficient)
+		} else if opts.Objects != nil {
+			s.g.Expect(opts.Objects).To(gomega.HaveLen(len(unstructuredObjs)), errObjectsWrongLength)
+		}
+
+		// Merge onto live state
+		if opts.MergeOntoLive {
+			s.g.Expect(util.ForEach(opts.Concurrency, len(unstructuredObjs), func(i int) error {
+				return s.mergeOntoLive(ctx, &unstructuredObjs[i])
+			})).To(gomega.Succeed(), errFailedGetLiveState)
+		}
+
+		// Update resources
+		s.g.Expect(s.updateEach(ctx, objectRefs(unstructuredObjs), opts)).To(gomega.Succeed(), errFailedUpdateWithTemplate)
+
+		// Wait for cache to sync
+		s.waitForResourceVersions(ctx, objectRefs(unstructuredObjs), opts)
+
+		// Wait for reconciliation
+		s.waitForReconciliation(ctx, objectRefs(unstructuredObjs), opts)
+
+		// Save objects
+		if opts.Object != nil {
+			s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObjs[0], opts.Object)).To(gomega.Succeed(), errFailedSave)
+		} else if opts.Objects != nil {
+			for i, unstructuredObj := range unstructuredObjs {
+				s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObj, opts.Objects[i])).To(gomega.Succeed(), errFailedSave)
+			}
+		}
+	} else if opts.Object != nil {
+		// Update resource
+		s.g.Expect(s.update(ctx, opts.Object, opts)).To(gomega.Succeed(), errFailedUpdateWithObject)
+
+		// Wait for cache to sync
+		updatedResourceVersion := opts.Object.GetResourceVersion()
+		s.waitForCacheSync(s.checkResourceVersionF(ctx, opts.Object, updatedResourceVersion), opts)
+
+		// Wait for reconciliation
+		s.waitForReconciliation(ctx, []client.Object{opts.Object}, opts)
+	} else {
+		// Update resources
+		s.g.Expect(s.updateEach(ctx, opts.Objects, opts)).To(gomega.Succeed(), errFailedUpdateWithObject)
+
+		// Wait for cache to sync
+		s.waitForResourceVersions(ctx, opts.Objects, opts)
+
+		// Wait for reconciliation
+		s.waitForReconciliation(ctx, opts.Objects, opts)
+	}
+}
+
+// UpdateExpectError attempts to update resources with objects, a manifest, or a Chainsaw template, and
+// returns the first error returned by the API server instead of failing the test. This is useful for
+// testing that invalid changes (e.g. to immutable fields) are rejected by validation webhooks, CEL
+// validation rules, etc.
+//
+// Resources are updated in order until one is rejected.
+//
+// Use the returned error with HaveStatusReason, HaveStatusCode, HaveStatusCause, or standard Gomega
+// matchers to assert the reason for the rejection.
+//
+// Invalid input and successful update of all resources will result in immediate test failure.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Object (client.Object): Typed or unstructured object for reading/writing the state of a single
+//     resource. If provided without a template, resource state will be read from the object for updating.
+//     If provided with a template, resource state will be read from the template and written to the object
+//     before updating.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for reading/writing the states of
+//     multiple resources. If provided without a template, resource states will be read from the objects for
+//     updating. If provided with a template, resource states will be read from the template and written to
+//     the objects before updating.
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing complete
+//     resource definitions to be read for updating. If provided with an object, must contain exactly one
+//     resource definition matching the type of the object. If provided with a slice of objects, must
+//     contain resource definitions exactly matching the count, order, and types of the objects.
+//
+//   - Bindings (map[string]any): Bindings to be applied to a Chainsaw template (if provided) in addition to
+//     (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Client Options (client.UpdateOption): Options for the update requests (e.g. client.DryRunAll).
+//
+//   - Subresource (StatusSubresource or ScaleSubresource): Updates the given subresource instead of the
+//     main resource.
+//
+// A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
+// may not be provided together. All other arguments are optional.
+//
+// # Examples
+//
+// Assert that an immutable field cannot be changed:
+//
+//	err := sc.UpdateExpectError(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: test-cm
+//	    namespace: default
+//	  immutable: true
+//	  data:
+//	    key: changed-value
+//	`)
+//	Expect(err).To(sc.HaveStatusReason(metav1.StatusReasonInvalid))
+//	Expect(err).To(sc.HaveStatusCause(metav1.StatusCause{Field: "data", Message: "field is immutable"}))
+func (s *Sawchain) UpdateExpectError(ctx context.Context, args ...interface{}) error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndRequireImmediate(&s.opts, includeUpdateExpectError, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
+	// Update resources until rejected
+	var rejection error
+	for _, obj := range s.writeTargets(ctx, opts) {
+		if rejection = s.update(ctx, obj, opts); rejection != nil {
+			break
+		}
+	}
+	s.g.Expect(rejection).To(gomega.HaveOccurred(), errUnexpectedUpdateSuccess)
+	return rejection
+}
+
+// Upsert creates resources that don't exist and updates resources that do with objects, a manifest, or a
+// Chainsaw template (like Chainsaw's apply operation), and ensures client Get operations for all resources
+// reflect the changes within a configurable duration before returning.
+//
+// Each resource is looked up before it is written. Resources that are not found are created in dependency
+// order (and automatically deleted when the test ends, like with Create), and resources that are found are
+// updated using the live resource version unless one is provided. Resources created by someone else since
+// the lookup are updated instead, and resources with generated names (metadata.generateName without
+// metadata.name) are always created. Upsert is useful for setting up fixtures against long-lived clusters
+// where the resources may already exist.
+//
+// If testing with a cached client, this ensures the client cache is synced and it is safe to make
+// assertions on the resources immediately after execution.
+//
+// Invalid input, client errors, and timeout errors will result in immediate test failure.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Object (client.Object): Typed or unstructured object for reading/writing the state of a single
+//     resource. If provided without a template, resource state will be read from the object for upsert.
+//     If provided with a template, resource state will be read from the template. In both cases, the
+//     resulting state will be written to the object. State will be maintained in the original input format,
+//     which may require internal type conversions using the client scheme.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for reading/writing the states of
+//     multiple resources. If provided without a template, resource states will be read from the objects for
+//     upsert. If provided with a template, resource states will be read from the template. In both cases, the
+//     resulting states will be written to the objects. States will be maintained in the original input
+//     format, which may require internal type conversions using the client scheme.
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing complete
+//     resource definitions to be read for upsert. If provided with an object, must contain exactly one
+//     resource definition matching the type of the object. If provided with a slice of objects, must
+//     contain resource definitions exactly matching the count, order, and types of the objects.
+//
+//   - Bindings (map[string]any): Bindings to be applied to a Chainsaw template (if provided) in addition to
+//     (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Client Options (client.CreateOption or client.UpdateOption): Options for the create and update
+//     requests (e.g. client.FieldOwner).
+//
+//   - Dry Run (client.DryRunAll): Sends the requests as server-side dry runs. Nothing is persisted, waiting
+//     is skipped, and the state returned by the API server (e.g. after defaulting and admission) is written
+//     to the provided object(s).
+//
+//   - Timeout (string or time.Duration): Duration within which client Get operations for all resources
+//     should reflect the changes. If provided, must be before interval. Defaults to Sawchain's global
+//     timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval for checking the resources after upsert.
+//     If provided, must be after timeout. Defaults to Sawchain's global interval value.
+//
+//   - WaitForReconciliation (or WaitForReconciliationAt): Waits within the timeout for controllers to
+//     reconcile the resources (observed generation reaching the resource generation) before returning.
+//     Implied if provided to New.
+//
+//   - SkipCleanup: Disables automatic cleanup of the created resources, allowing them to outlive the
+//     test. Implied if provided to New. Updated resources are never cleaned up.
+//
+//   - Concurrency: Maximum number of resources to create or update at a time. If greater than 1, all
+//     create errors are reported together. Defaults to Sawchain's global concurrency (1 if not set).
+//
+// A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
+// may not be provided together. All other arguments are optional.
+//
+// # Examples
+//
+// Create or update a single resource with an object:
+//
+//	sc.Upsert(ctx, obj)
+//
+// Create or update multiple resources with a manifest file:
+//
+//	sc.Upsert(ctx, "path/to/fixtures.yaml")
+//
+// Create or update a single resource with a Chainsaw template and save the resource's state to an object:
+//
+//	sc.Upsert(ctx, configMap, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  data:
+//	    key: value
+//	`, map[string]any{"name": "test-cm", "namespace": "default"})
+func (s *Sawchain) Upsert(ctx context.Context, args ...interface{}) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndRequireEventual(&s.opts, includeUpsert, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
+	var unstructuredObjs []unstructured.Unstructured
+	errFailedUpsert := errFailedUpsertWithObject
+	if len(opts.Template) > 0 {
+		// Render template
+		unstructuredObjs, err = chainsaw.RenderTemplate(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+		s.defaultNamespace(objectRefs(unstructuredObjs)...)
+		errFailedUpsert = errFailedUpsertWithTemplate
 
 		// Validate objects length
 		if opts.Object != nil {
@@ -299,263 +2151,561 @@ func (s *Sawchain) Create(ctx context.Context, args ...interface{}) {
 		} else if opts.Objects != nil {
 			s.g.Expect(opts.Objects).To(gomega.HaveLen(len(unstructuredObjs)), errObjectsWrongLength)
 		}
+	} else {
+		// Convert objects
+		unstructuredObjs = s.convertObjects(opts)
+	}
 
-		// Create resources
-		for _, unstructuredObj := range unstructuredObjs {
-			s.g.Expect(s.c.Create(ctx, &unstructuredObj)).To(gomega.Succeed(), errFailedCreateWithTemplate)
+	// Look up resources to decide whether to create or update them
+	objs := objectRefs(unstructuredObjs)
+	var createIndices []int
+	var toUpdate []client.Object
+	for i, obj := range objs {
+		if obj.GetName() == "" && obj.GetGenerateName() != "" {
+			// Resources with generated names are always created
+			createIndices = append(createIndices, i)
+			continue
 		}
-
-		// Wait for cache to sync
-		getAll := func() error {
-			for i := range unstructuredObjs {
-				// Use index to update object in outer scope
-				if err := s.get(ctx, &unstructuredObjs[i]); err != nil {
-					return err
-				}
-			}
-			return nil
+		existing := unstructuredObjs[i].DeepCopy()
+		err := s.get(ctx, existing)
+		if apierrors.IsNotFound(err) {
+			createIndices = append(createIndices, i)
+			continue
 		}
-		s.g.Eventually(getAll, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errCacheNotSynced)
-
-		// Save objects
-		if opts.Object != nil {
-			s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObjs[0], opts.Object)).To(gomega.Succeed(), errFailedSave)
-		} else if opts.Objects != nil {
-			for i, unstructuredObj := range unstructuredObjs {
-				s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObj, opts.Objects[i])).To(gomega.Succeed(), errFailedSave)
-			}
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedUpsert)
+		if obj.GetResourceVersion() == "" {
+			obj.SetResourceVersion(existing.GetResourceVersion())
 		}
-	} else if opts.Object != nil {
-		// Create resource
-		s.g.Expect(s.c.Create(ctx, opts.Object)).To(gomega.Succeed(), errFailedCreateWithObject)
+		toUpdate = append(toUpdate, obj)
+	}
 
-		// Wait for cache to sync
-		s.g.Eventually(s.getF(ctx, opts.Object), opts.Timeout, opts.Interval).Should(gomega.Succeed(), errCacheNotSynced)
-	} else {
-		// Create resources
-		for _, obj := range opts.Objects {
-			s.g.Expect(s.c.Create(ctx, obj)).To(gomega.Succeed(), errFailedCreateWithObject)
-		}
+	// Create missing resources in dependency order, updating resources created since the lookup
+	toCreate := make([]client.Object, len(createIndices))
+	for j, i := range createIndices {
+		toCreate[j] = objs[i]
+	}
+	created := make([]bool, len(objs))
+	for j, ok := range s.createInOrder(ctx, toCreate, opts, errFailedUpsert, func(obj client.Object) error {
+		return s.updateExisting(ctx, obj, opts)
+	}) {
+		created[createIndices[j]] = ok
+	}
 
-		// Wait for cache to sync
-		getAll := func() error {
-			for _, obj := range opts.Objects {
-				if err := s.get(ctx, obj); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-		s.g.Eventually(getAll, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errCacheNotSynced)
+	// Update existing resources
+	s.g.Expect(s.updateEach(ctx, toUpdate, opts)).To(gomega.Succeed(), errFailedUpsert)
+
+	// Wait for cache to sync
+	minResourceVersions := make([]string, len(objs))
+	for i := range objs {
+		minResourceVersions[i] = objs[i].GetResourceVersion()
 	}
+	checkAll := checkEachF(len(objs), opts, func(i int) error {
+		if created[i] {
+			return s.get(ctx, objs[i])
+		}
+		return s.checkResourceVersion(ctx, objs[i], minResourceVersions[i])
+	})
+	s.waitForCacheSync(checkAll, opts)
+
+	// Wait for reconciliation
+	s.waitForReconciliation(ctx, objs, opts)
+
+	// Save objects
+	s.saveObjects(unstructuredObjs, opts)
 }
 
-// TODO: test
-// Update updates resources with objects, a manifest, or a Chainsaw template, and ensures client Get
-// operations for all resources reflect the updates within a configurable duration before returning.
+// Apply performs server-side apply with objects, a manifest, or a Chainsaw template, and ensures client
+// Get operations for all resources reflect the applied state within a configurable duration before
+// returning.
+//
+// Unlike Update, the provided resource definitions only need to contain the fields owned by the field
+// manager; fields owned by other managers (e.g. controllers) are left untouched. Resource versions and
+// managed fields are ignored, so objects from previous operations may be reused as apply configurations.
 //
 // If testing with a cached client, this ensures the client cache is synced and it is safe to make
-// assertions on the updated resources immediately after execution.
+// assertions on the applied resources immediately after execution.
 //
-// Invalid input, client errors, and timeout errors will result in immediate test failure.
+// Invalid input, client errors (including field manager conflicts), and timeout errors will result in
+// immediate test failure.
 //
 // # Arguments
 //
 // The following arguments may be provided in any order (unless noted otherwise) after the context:
 //
 //   - Object (client.Object): Typed or unstructured object for reading/writing the state of a single
-//     resource. If provided without a template, resource state will be read from the object for update.
-//     If provided with a template, resource state will be read from the template and written to the object.
-//     State will be maintained in the original input format, which may require internal type conversions
-//     using the client scheme.
+//     resource. If provided without a template, resource state will be read from the object for apply.
+//     If provided with a template, resource state will be read from the template. In both cases, the
+//     applied state will be written to the object. State will be maintained in the original input format,
+//     which may require internal type conversions using the client scheme.
 //
 //   - Objects ([]client.Object): Slice of typed or unstructured objects for reading/writing the states of
 //     multiple resources. If provided without a template, resource states will be read from the objects for
-//     update. If provided with a template, resource states will be read from the template and written to
-//     the objects. States will be maintained in the original input format, which may require internal type
-//     conversions using the client scheme.
+//     apply. If provided with a template, resource states will be read from the template. In both cases, the
+//     applied states will be written to the objects. States will be maintained in the original input format,
+//     which may require internal type conversions using the client scheme.
 //
-//   - Template (string): File path or content of a static manifest or Chainsaw template containing complete
-//     resource definitions to be read for update. If provided with an object, must contain exactly one
-//     resource definition matching the type of the object. If provided with a slice of objects, must
-//     contain resource definitions exactly matching the count, order, and types of the objects.
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing the
+//     identifying metadata and desired fields of the resources to be applied. If provided with an object,
+//     must contain exactly one resource definition matching the type of the object. If provided with a slice
+//     of objects, must contain resource definitions exactly matching the count, order, and types of the
+//     objects.
 //
 //   - Bindings (map[string]any): Bindings to be applied to a Chainsaw template (if provided) in addition to
 //     (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
 //     natural order.
 //
+//   - Field Manager (client.FieldOwner): Field manager for the apply. Defaults to DefaultFieldManager.
+//
+//   - Force (client.ForceOwnership): Forces the apply to take ownership of conflicting fields instead of
+//     failing.
+//
+//   - Dry Run (client.DryRunAll): Sends the requests as server-side dry runs. Nothing is persisted, waiting
+//     is skipped, and the state returned by the API server (e.g. after defaulting and admission) is written
+//     to the provided object(s).
+//
 //   - Timeout (string or time.Duration): Duration within which client Get operations for all resources
-//     should reflect the updates. If provided, must be before interval. Defaults to Sawchain's
+//     should reflect the applied state. If provided, must be before interval. Defaults to Sawchain's
 //     global timeout value.
 //
-//   - Interval (string or time.Duration): Polling interval for checking the resources after updating.
+//   - Interval (string or time.Duration): Polling interval for checking the resources after applying.
 //     If provided, must be after timeout. Defaults to Sawchain's global interval value.
 //
+//   - WaitForReconciliation (or WaitForReconciliationAt): Waits within the timeout for controllers to
+//     reconcile the resources (observed generation reaching the resource generation) before returning.
+//     Implied if provided to New.
+//
 // A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
 // may not be provided together. All other arguments are optional.
 //
 // # Examples
 //
-// Update a single resource with an object:
+// Apply a single resource with an object:
 //
-//	sc.UpdateResourceAndWait(ctx, obj)
+//	sc.Apply(ctx, obj)
 //
-// Update multiple resources with objects:
+// Apply multiple resources with objects using a custom field manager:
 //
-//	sc.Update(ctx, []client.Object{obj1, obj2, obj3})
+//	sc.Apply(ctx, []client.Object{obj1, obj2, obj3}, client.FieldOwner("my-controller"))
 //
-// Update resources with a manifest file and override duration settings:
+// Apply a single resource with a Chainsaw template, taking ownership of conflicting fields:
 //
-//	sc.Update(ctx, "path/to/manifest.yaml", "10s", "2s")
+//	sc.Apply(ctx, `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  spec:
+//	    replicas: 3
+//	`, map[string]any{"name": "test-deployment", "namespace": "default"}, client.ForceOwnership)
 //
-// Update a single resource with a Chainsaw template and bindings:
+// Apply a single resource with a Chainsaw template and save the resource's applied state to an object:
 //
-//	sc.UpdateResourceAndWait(ctx, `
+//	sc.Apply(ctx, configMap, `
 //	  apiVersion: v1
 //	  kind: ConfigMap
 //	  metadata:
 //	    name: ($name)
 //	    namespace: ($namespace)
 //	  data:
-//	    key: updated-value
+//	    key: applied-value
 //	`, map[string]any{"name": "test-cm", "namespace": "default"})
+func (s *Sawchain) Apply(ctx context.Context, args ...interface{}) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndRequireEventual(&s.opts, includeApply, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
+	var unstructuredObjs []unstructured.Unstructured
+	if len(opts.Template) > 0 {
+		// Render template
+		unstructuredObjs, err = chainsaw.RenderTemplate(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+		s.defaultNamespace(objectRefs(unstructuredObjs)...)
+
+		// Validate objects length
+		if opts.Object != nil {
+			s.g.Expect(unstructuredObjs).To(gomega.HaveLen(1), errObjectInsufficient)
+		} else if opts.Objects != nil {
+			s.g.Expect(opts.Objects).To(gomega.HaveLen(len(unstructuredObjs)), errObjectsWrongLength)
+		}
+
+		// Apply resources
+		for i := range unstructuredObjs {
+			s.g.Expect(s.apply(ctx, &unstructuredObjs[i], opts)).To(gomega.Succeed(), errFailedApplyWithTemplate)
+		}
+	} else {
+		// Convert objects
+		unstructuredObjs = s.convertObjects(opts)
+
+		// Apply resources
+		for i := range unstructuredObjs {
+			s.g.Expect(s.apply(ctx, &unstructuredObjs[i], opts)).To(gomega.Succeed(), errFailedApplyWithObject)
+		}
+	}
+
+	// Wait for cache to sync
+	s.waitForResourceVersions(ctx, objectRefs(unstructuredObjs), opts)
+
+	// Wait for reconciliation
+	s.waitForReconciliation(ctx, objectRefs(unstructuredObjs), opts)
+
+	// Save objects
+	s.saveObjects(unstructuredObjs, opts)
+}
+
+// Patch patches resources with a partial Chainsaw template, and ensures client Get operations for all
+// resources reflect the patches within a configurable duration before returning.
 //
-// Update a single resource with a Chainsaw template and save the resource's updated state to an object:
+// By default, each template document is sent as a JSON merge patch containing the identifying metadata
+// of the resource plus the fields to change. Strategic merge patches are sent the same way. JSON patches
+// (RFC 6902) instead use a template containing a list of patch operations, which is applied to each of the
+// resources identified by the provided object or objects.
 //
-//	sc.UpdateResourceAndWait(ctx, configMap, `
+// If testing with a cached client, this ensures the client cache is synced and it is safe to make
+// assertions on the patched resources immediately after execution.
+//
+// Invalid input, client errors, and timeout errors will result in immediate test failure.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Object (client.Object): Typed or unstructured object for writing the patched state of a single
+//     resource. Required to identify the resource for JSON patches. State will be maintained in the
+//     original input format, which may require internal type conversions using the client scheme.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for writing the patched states of
+//     multiple resources. Required to identify the resources for JSON patches. States will be maintained in
+//     the original input format, which may require internal type conversions using the client scheme.
+//
+//   - Template (string): File path or content of a Chainsaw template to be rendered into the patch. For
+//     merge and strategic merge patches, must contain the identifying metadata of the resources plus the
+//     fields to change; if provided with an object, must contain exactly one resource definition matching
+//     the type of the object, and if provided with a slice of objects, must contain resource definitions
+//     exactly matching the count, order, and types of the objects. For JSON patches, must contain a list
+//     of patch operations.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template in addition to (or
+//     overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Patch Type (types.PatchType): One of types.MergePatchType, types.StrategicMergePatchType, or
+//     types.JSONPatchType. Defaults to types.MergePatchType.
+//
+//   - Client Options (client.PatchOption): Options for the patch request (e.g. client.FieldOwner).
+//
+//   - Subresource (StatusSubresource or ScaleSubresource): Patches the given subresource instead of the
+//     main resource. For scale merge patches, only the required spec.replicas field of each template document
+//     is sent.
+//
+//   - Dry Run (client.DryRunAll): Sends the requests as server-side dry runs. Nothing is persisted, waiting
+//     is skipped, and the state returned by the API server (e.g. after defaulting and admission) is written
+//     to the provided object(s).
+//
+//   - Timeout (string or time.Duration): Duration within which client Get operations for all resources
+//     should reflect the patches. If provided, must be before interval. Defaults to Sawchain's
+//     global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval for checking the resources after patching.
+//     If provided, must be after timeout. Defaults to Sawchain's global interval value.
+//
+//   - WaitForReconciliation (or WaitForReconciliationAt): Waits within the timeout for controllers to
+//     reconcile the resources (observed generation reaching the resource generation) before returning.
+//     Implied if provided to New.
+//
+// A template must be provided. All other arguments are optional unless noted otherwise.
+//
+// # Examples
+//
+// Patch a single field of a resource with a JSON merge patch:
+//
+//	sc.Patch(ctx, `
 //	  apiVersion: v1
 //	  kind: ConfigMap
 //	  metadata:
 //	    name: ($name)
 //	    namespace: ($namespace)
 //	  data:
-//	    key: updated-value
+//	    key: patched-value
 //	`, map[string]any{"name": "test-cm", "namespace": "default"})
 //
-// Update multiple resources with a Chainsaw template and bindings:
+// Patch a Deployment container with a strategic merge patch and save the patched state to an object:
 //
-//	sc.Update(ctx, `
-//	  apiVersion: v1
-//	  kind: ConfigMap
+//	sc.Patch(ctx, deployment, types.StrategicMergePatchType, `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
 //	  metadata:
-//	    name: (join('-', [$prefix, 'cm']))
-//	    namespace: ($namespace)
-//	  data:
-//	    key: updated-value
-//	  ---
-//	  apiVersion: v1
-//	  kind: Secret
+//	    name: test-deployment
+//	    namespace: default
+//	  spec:
+//	    template:
+//	      spec:
+//	        containers:
+//	        - name: app
+//	          image: ($image)
+//	`, map[string]any{"image": "nginx:latest"})
+//
+// Scale a Deployment through the scale subresource:
+//
+//	sc.Patch(ctx, sawchain.ScaleSubresource, `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
 //	  metadata:
-//	    name: (join('-', [$prefix, 'secret']))
-//	    namespace: ($namespace)
-//	  type: Opaque
-//	  stringData:
-//	    username: admin
-//	    password: updated-secret
-//	`, map[string]any{"prefix": "test", "namespace": "default"})
+//	    name: test-deployment
+//	    namespace: default
+//	  spec:
+//	    replicas: 3
+//	`)
 //
-// Update multiple resources with a Chainsaw template and save the resources' updated states to objects:
+// Patch an object's resource with a JSON patch:
+//
+//	sc.Patch(ctx, configMap, types.JSONPatchType, `
+//	  - op: replace
+//	    path: /data/key
+//	    value: ($value)
+//	  - op: remove
+//	    path: /data/obsolete
+//	`, map[string]any{"value": "patched-value"})
+func (s *Sawchain) Patch(ctx context.Context, args ...interface{}) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndRequireEventualTemplate(&s.opts, includePatch, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
+	// Validate patch type
+	patchType := opts.PatchType
+	if patchType == "" {
+		patchType = apitypes.MergePatchType
+	}
+	s.g.Expect(patchType).To(gomega.BeElementOf(
+		apitypes.MergePatchType, apitypes.StrategicMergePatchType, apitypes.JSONPatchType), errPatchTypeInvalid)
+
+	var unstructuredObjs []unstructured.Unstructured
+	if patchType == apitypes.JSONPatchType {
+		// Render patch
+		data, err := chainsaw.RenderJSONPatch(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+
+		// Identify resources
+		objs := opts.Objects
+		if opts.Object != nil {
+			objs = []client.Object{opts.Object}
+		}
+		s.g.Expect(objs).NotTo(gomega.BeEmpty(), errJSONPatchNoObject)
+		unstructuredObjs = make([]unstructured.Unstructured, len(objs))
+		for i, obj := range objs {
+			unstructuredObjs[i], err = util.UnstructuredRefFromObject(obj, s.c.Scheme())
+			s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedConvertToUnstructured)
+		}
+
+		// Patch resources
+		for i := range unstructuredObjs {
+			s.g.Expect(s.patch(ctx, &unstructuredObjs[i], client.RawPatch(patchType, data), opts)).To(
+				gomega.Succeed(), errFailedPatchWithObject)
+		}
+	} else {
+		// Render template
+		unstructuredObjs, err = chainsaw.RenderTemplate(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+		s.defaultNamespace(objectRefs(unstructuredObjs)...)
+
+		// Validate objects length
+		if opts.Object != nil {
+			s.g.Expect(unstructuredObjs).To(gomega.HaveLen(1), errObjectInsufficient)
+		} else if opts.Objects != nil {
+			s.g.Expect(opts.Objects).To(gomega.HaveLen(len(unstructuredObjs)), errObjectsWrongLength)
+		}
+
+		// Patch resources
+		for i := range unstructuredObjs {
+			patchContent := unstructuredObjs[i].Object
+			if opts.Subresource == string(ScaleSubresource) {
+				// Scale only shares the spec.replicas field with the resource
+				replicas, found, err := unstructured.NestedFieldNoCopy(patchContent, "spec", "replicas")
+				s.g.Expect(err).NotTo(gomega.HaveOccurred(), errScaleNoReplicas)
+				s.g.Expect(found).To(gomega.BeTrue(), "%s: %s", s.id(&unstructuredObjs[i]), errScaleNoReplicas)
+				patchContent = map[string]any{"spec": map[string]any{"replicas": replicas}}
+			}
+			data, err := json.Marshal(patchContent)
+			s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedMarshalObject)
+			// Patch result replaces the partial object in outer scope
+			s.g.Expect(s.patch(ctx, &unstructuredObjs[i], client.RawPatch(patchType, data), opts)).To(
+				gomega.Succeed(), errFailedPatchWithTemplate)
+		}
+	}
+
+	// Wait for cache to sync
+	s.waitForResourceVersions(ctx, objectRefs(unstructuredObjs), opts)
+
+	// Wait for reconciliation
+	s.waitForReconciliation(ctx, objectRefs(unstructuredObjs), opts)
+
+	// Save objects
+	s.saveObjects(unstructuredObjs, opts)
+}
+
+// Mutate fetches the live state of a resource, modifies it with a mutation function and/or a partial
+// Chainsaw template, and updates it, retrying from a fresh fetch on conflict errors until the update
+// succeeds or the timeout elapses. It then ensures client Get operations for the resource reflect the
+// update within a configurable duration before returning.
+//
+// Unlike Update, Mutate never sends a stale resource version, so it is safe to use on resources that
+// are concurrently modified (e.g. reconciled by a controller).
+//
+// If testing with a cached client, this ensures the client cache is synced and it is safe to make
+// assertions on the mutated resource immediately after execution.
+//
+// Invalid input, client errors other than conflicts, mutation function errors, and timeout errors will
+// result in immediate test failure.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Object (client.Object): Typed or unstructured object identifying the resource (if no template is
+//     provided) and for writing the final state of the resource. State will be maintained in the original
+//     input format, which may require internal type conversions using the client scheme.
+//
+//   - Template (string): File path or content of a Chainsaw template containing the identifying metadata
+//     of the resource plus the fields to change, which will be deep merged onto the live state (maps are
+//     merged, all other values are replaced). Must contain exactly one resource definition.
+//
+//   - Mutation (func(T) or func(T) error, where T implements client.Object): Function that modifies the
+//     live state of the resource in place. Receives an object of the same type as the provided object,
+//     or the typed form of the resource if no object is provided. Applied after the template (if any).
+//     May be called multiple times.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Subresource (StatusSubresource or ScaleSubresource): Updates the given subresource instead of the
+//     main resource.
+//
+//   - Client Options (client.UpdateOption): Options for the update requests (e.g. client.FieldOwner).
+//
+//   - Dry Run (client.DryRunAll): Sends the requests as server-side dry runs. Nothing is persisted, waiting
+//     is skipped, and the state returned by the API server (e.g. after defaulting and admission) is written
+//     to the provided object(s).
+//
+//   - Timeout (string or time.Duration): Duration within which the update should succeed and client Get
+//     operations for the resource should reflect it. If provided, must be before interval. Defaults to
+//     Sawchain's global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval for retrying the update and checking the
+//     resource after updating. If provided, must be after timeout. Defaults to Sawchain's global interval
+//     value.
+//
+//   - WaitForReconciliation (or WaitForReconciliationAt): Waits within the timeout for controllers to
+//     reconcile the resources (observed generation reaching the resource generation) before returning.
+//     Implied if provided to New.
+//
+// A template or an object must be provided, as well as a template or a mutation function. All other
+// arguments are optional.
+//
+// # Examples
+//
+// Mutate a typed object's resource with a function and save the final state to the object:
+//
+//	sc.Mutate(ctx, configMap, func(cm *corev1.ConfigMap) {
+//		cm.Data["key"] = "mutated-value"
+//	})
+//
+// Mutate a resource with a partial Chainsaw template:
 //
-//	sc.Update(ctx, []client.Object{configMap, secret}, `
-//	  apiVersion: v1
-//	  kind: ConfigMap
-//	  metadata:
-//	    name: (join('-', [$prefix, 'cm']))
-//	    namespace: ($namespace)
-//	  data:
-//	    key: updated-value
-//	  ---
-//	  apiVersion: v1
-//	  kind: Secret
+//	sc.Mutate(ctx, `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
 //	  metadata:
-//	    name: (join('-', [$prefix, 'secret']))
+//	    name: ($name)
 //	    namespace: ($namespace)
-//	  type: Opaque
-//	  stringData:
-//	    username: admin
-//	    password: updated-secret
-//	`, map[string]any{"prefix": "test", "namespace": "default"})
-func (s *Sawchain) Update(ctx context.Context, args ...interface{}) {
+//	    annotations:
+//	      example.com/paused: "true"
+//	`, map[string]any{"name": "test-deployment", "namespace": "default"})
+//
+// Mutate the status of a resource with a function that may fail:
+//
+//	sc.Mutate(ctx, deployment, sawchain.StatusSubresource, func(d *appsv1.Deployment) error {
+//		if d.Status.ObservedGeneration == 0 {
+//			return errors.New("deployment not observed yet")
+//		}
+//		d.Status.ReadyReplicas = 1
+//		return nil
+//	})
+func (s *Sawchain) Mutate(ctx context.Context, args ...interface{}) {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndRequireEventual(&s.opts, args...)
+	opts, err := options.ParseAndRequireEventualMutation(&s.opts, includeMutate, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
+	// Identify resource
+	var partial, ref unstructured.Unstructured
 	if len(opts.Template) > 0 {
 		// Render template
-		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
+		partial, err = chainsaw.RenderTemplateSingle(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+		s.defaultNamespace(&partial)
+		// A resource version from the template would cause a conflict on every attempt
+		unstructured.RemoveNestedField(partial.Object, "metadata", "resourceVersion")
+		ref, err = util.UnstructuredRefFromObject(&partial, s.c.Scheme())
+	} else {
+		ref, err = util.UnstructuredRefFromObject(opts.Object, s.c.Scheme())
+	}
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedConvertToUnstructured)
 
-		// Validate objects length
-		if opts.Object != nil {
-			s.g.Expect(unstructuredObjs).To(gomega.HaveLen(1), errObjectInsufficient)
-		} else if opts.Objects != nil {
-			s.g.Expect(opts.Objects).To(gomega.HaveLen(len(unstructuredObjs)), errObjectsWrongLength)
-		}
-
-		// Update resources
-		for _, unstructuredObj := range unstructuredObjs {
-			s.g.Expect(s.c.Update(ctx, &unstructuredObj)).To(gomega.Succeed(), errFailedUpdateWithTemplate)
+	// Mutate resource, retrying on conflict
+	var mutated unstructured.Unstructured
+	mutate := func() error {
+		live := *ref.DeepCopy()
+		if err := s.get(ctx, &live); err != nil {
+			return err
 		}
-
-		// Wait for cache to sync
-		updatedResourceVersions := make([]string, len(unstructuredObjs))
-		for i := range unstructuredObjs {
-			updatedResourceVersions[i] = unstructuredObjs[i].GetResourceVersion()
+		if len(opts.Template) > 0 {
+			live.Object = util.MergeMapsDeep(live.Object, partial.Object)
 		}
-		checkAll := func() error {
-			for i := range unstructuredObjs {
-				// Use index to update object in outer scope
-				if err := s.checkResourceVersion(ctx, &unstructuredObjs[i], updatedResourceVersions[i]); err != nil {
-					return err
-				}
+		if opts.Mutation != nil {
+			if err := s.applyMutation(&live, opts); err != nil {
+				return gomega.StopTrying(errFailedMutate).Wrap(err)
 			}
-			return nil
 		}
-		s.g.Eventually(checkAll, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errCacheNotSynced)
-
-		// Save objects
-		if opts.Object != nil {
-			s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObjs[0], opts.Object)).To(gomega.Succeed(), errFailedSave)
-		} else if opts.Objects != nil {
-			for i, unstructuredObj := range unstructuredObjs {
-				s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObj, opts.Objects[i])).To(gomega.Succeed(), errFailedSave)
+		if err := s.update(ctx, &live, opts); err != nil {
+			if apierrors.IsConflict(err) {
+				return err
 			}
+			return gomega.StopTrying(errFailedMutate).Wrap(err)
 		}
-	} else if opts.Object != nil {
-		// Update resource
-		s.g.Expect(s.c.Update(ctx, opts.Object)).To(gomega.Succeed(), errFailedUpdateWithObject)
+		mutated = live
+		return nil
+	}
+	s.g.Eventually(mutate, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errFailedMutate)
 
-		// Wait for cache to sync
-		updatedResourceVersion := opts.Object.GetResourceVersion()
-		s.g.Eventually(s.checkResourceVersionF(ctx, opts.Object, updatedResourceVersion),
-			opts.Timeout, opts.Interval).Should(gomega.Succeed(), errCacheNotSynced)
-	} else {
-		// Update resources
-		for _, obj := range opts.Objects {
-			s.g.Expect(s.c.Update(ctx, obj)).To(gomega.Succeed(), errFailedUpdateWithObject)
-		}
+	// Wait for cache to sync
+	mutatedObjs := []unstructured.Unstructured{mutated}
+	s.waitForResourceVersions(ctx, objectRefs(mutatedObjs), opts)
 
-		// Wait for cache to sync
-		updatedResourceVersions := make([]string, len(opts.Objects))
-		for i := range opts.Objects {
-			updatedResourceVersions[i] = opts.Objects[i].GetResourceVersion()
-		}
-		checkAll := func() error {
-			for i := range opts.Objects {
-				if err := s.checkResourceVersion(ctx, opts.Objects[i], updatedResourceVersions[i]); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-		s.g.Eventually(checkAll, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errCacheNotSynced)
-	}
+	// Wait for reconciliation
+	s.waitForReconciliation(ctx, objectRefs(mutatedObjs), opts)
+
+	// Save object
+	s.saveObjects(mutatedObjs, opts)
 }
 
-// TODO: test
 // Delete deletes resources with objects, a manifest, or a Chainsaw template, and ensures client Get
 // operations for all resources reflect the deletion (resources not found) within a configurable
 // duration before returning.
@@ -563,6 +2713,13 @@ func (s *Sawchain) Update(ctx context.Context, args ...interface{}) {
 // If testing with a cached client, this ensures the client cache is synced and it is safe to make
 // assertions on the resources' absence immediately after execution.
 //
+// Resources are deleted in the reverse of the order used by Create: custom resources and other resources
+// first, followed by CRDs, followed by Namespaces.
+//
+// If deletion is blocked by finalizers, the failure message lists the remaining finalizers. With the
+// RemoveFinalizers argument, finalizers are instead removed from resources that still exist after the
+// timeout, which is useful in environments without controllers to handle them (e.g. envtest).
+//
 // Invalid input, client errors, and timeout errors will result in immediate test failure.
 //
 // # Arguments
@@ -577,7 +2734,10 @@ func (s *Sawchain) Update(ctx context.Context, args ...interface{}) {
 //     ignored.
 //
 //   - Template (string): File path or content of a static manifest or Chainsaw template containing the
-//     identifying metadata of the resources to be deleted. Takes precedence over objects.
+//     identifying metadata of the resources to be deleted. Takes precedence over objects. Resource
+//     definitions without a name select all resources of their kind matching their namespace (if any) and
+//     labels (if any), all of which will be deleted. Matching resources that appear while waiting (e.g.
+//     recreated by a controller) are deleted too, until none remain.
 //
 //   - Bindings (map[string]any): Bindings to be applied to a Chainsaw template (if provided) in addition to
 //     (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
@@ -590,6 +2750,18 @@ func (s *Sawchain) Update(ctx context.Context, args ...interface{}) {
 //   - Interval (string or time.Duration): Polling interval for checking the resources after deletion.
 //     If provided, must be after timeout. Defaults to Sawchain's global interval value.
 //
+//   - Delete Options (client.DeleteOption): Options for the delete requests, such as
+//     client.PropagationPolicy, client.GracePeriodSeconds, and client.Preconditions.
+//
+//   - Dry Run (client.DryRunAll): Sends the delete requests as server-side dry runs. Nothing is deleted
+//     and waiting is skipped.
+//
+//   - RemoveFinalizers: Removes finalizers from resources that are not deleted within the timeout,
+//     then waits for deletion again with the same timeout. Implied if provided to New.
+//
+//   - Concurrency: Maximum number of resources to delete at a time. If greater than 1, all delete errors
+//     are reported together. Defaults to Sawchain's global concurrency (1 if not set).
+//
 // A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
 // may not be provided together. All other arguments are optional.
 //
@@ -632,57 +2804,82 @@ func (s *Sawchain) Update(ctx context.Context, args ...interface{}) {
 //	    name: (join('-', [$prefix, 'secret']))
 //	    namespace: ($namespace)
 //	`, map[string]any{"prefix": "test", "namespace": "default"})
+//
+// Delete all ConfigMaps with a label in a namespace:
+//
+//	sc.Delete(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    namespace: ($namespace)
+//	    labels:
+//	      app: foo
+//	`, map[string]any{"namespace": "test"})
+//
+// Delete a resource in the foreground, removing finalizers if it gets stuck:
+//
+//	sc.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationForeground), sawchain.RemoveFinalizers)
 func (s *Sawchain) Delete(ctx context.Context, args ...interface{}) {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndRequireEventual(&s.opts, args...)
+	opts, err := options.ParseAndRequireEventual(&s.opts, includeDelete, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
+	deleteOpts := util.FilterByType[client.DeleteOption](opts.ClientOptions)
+
 	if len(opts.Template) > 0 {
 		// Render template
 		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+		s.defaultNamespace(objectRefs(unstructuredObjs)...)
+
+		// Identify resources in reverse dependency order
+		var objs, unnamed []client.Object
+		var listed []bool
+		for _, i := range s.deletionOrder(objectRefs(unstructuredObjs)) {
+			if unstructuredObjs[i].GetName() != "" {
+				objs = append(objs, &unstructuredObjs[i])
+				listed = append(listed, false)
+				continue
+			}
+			// Delete all resources matching the kind, namespace, and labels of unnamed documents
+			unnamed = append(unnamed, &unstructuredObjs[i])
+			candidates, err := chainsaw.ListCandidates(s.c, ctx, &unstructuredObjs[i])
+			s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedListWithTemplate)
+			for j := range candidates {
+				objs = append(objs, &candidates[j])
+				// Ignore resources deleted since listing
+				listed = append(listed, true)
+			}
+		}
 
 		// Delete resources
-		for _, unstructuredObj := range unstructuredObjs {
-			s.g.Expect(s.c.Delete(ctx, &unstructuredObj)).To(gomega.Succeed(), errFailedDeleteWithTemplate)
+		order := make([]int, len(objs))
+		for i := range order {
+			order[i] = i
 		}
+		s.deleteInOrder(ctx, objs, order, listed, opts, errFailedDeleteWithTemplate)
 
 		// Wait for cache to sync
-		checkAll := func() error {
-			for i := range unstructuredObjs {
-				// Use index to update object in outer scope
-				if err := s.checkNotFound(ctx, &unstructuredObjs[i]); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-		s.g.Eventually(checkAll, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errCacheNotSynced)
+		s.waitForDeletion(ctx, objs, opts)
+		s.waitForNoCandidates(ctx, unnamed, opts)
 	} else if opts.Object != nil {
 		// Delete resource
-		s.g.Expect(s.c.Delete(ctx, opts.Object)).To(gomega.Succeed(), errFailedDeleteWithObject)
+		s.g.Expect(s.c.Delete(ctx, opts.Object, deleteOpts...)).To(gomega.Succeed(), errFailedDeleteWithObject)
 
 		// Wait for cache to sync
-		s.g.Eventually(s.checkNotFoundF(ctx, opts.Object), opts.Timeout, opts.Interval).Should(gomega.Succeed(), errCacheNotSynced)
+		s.waitForDeletion(ctx, []client.Object{opts.Object}, opts)
 	} else {
-		// Delete resources
-		for _, obj := range opts.Objects {
-			s.g.Expect(s.c.Delete(ctx, obj)).To(gomega.Succeed(), errFailedDeleteWithObject)
-		}
+		// Delete resources in reverse dependency order
+		s.deleteInOrder(ctx, opts.Objects, s.deletionOrder(opts.Objects), nil, opts, errFailedDeleteWithObject)
 
 		// Wait for cache to sync
-		checkAll := func() error {
-			for _, obj := range opts.Objects {
-				if err := s.checkNotFound(ctx, obj); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-		s.g.Eventually(checkAll, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errCacheNotSynced)
+		s.waitForDeletion(ctx, opts.Objects, opts)
 	}
 }
 
@@ -694,14 +2891,24 @@ func (s *Sawchain) Get(ctx context.Context, args ...interface{}) error {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndRequireImmediate(&s.opts, args...)
+	opts, err := options.ParseAndRequireImmediate(&s.opts, includeGet, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
+	// Validate scale objects
+	if opts.Subresource == string(ScaleSubresource) {
+		s.g.Expect(opts.Template).NotTo(gomega.BeEmpty(), errScaleGetNoTemplate)
+		s.requireScaleObjects(opts)
+	}
+
 	if len(opts.Template) > 0 {
 		// Render template
 		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+		s.defaultNamespace(objectRefs(unstructuredObjs)...)
 
 		// Validate objects length
 		if opts.Object != nil {
@@ -711,8 +2918,8 @@ func (s *Sawchain) Get(ctx context.Context, args ...interface{}) error {
 		}
 
 		// Get resources
-		for _, unstructuredObj := range unstructuredObjs {
-			if err := s.c.Get(ctx, client.ObjectKeyFromObject(&unstructuredObj), &unstructuredObj); err != nil {
+		for i := range unstructuredObjs {
+			if err := s.read(ctx, &unstructuredObjs[i], opts.Subresource); err != nil {
 				return err
 			}
 		}
@@ -727,13 +2934,13 @@ func (s *Sawchain) Get(ctx context.Context, args ...interface{}) error {
 		}
 	} else if opts.Object != nil {
 		// Get resource
-		if err := s.c.Get(ctx, client.ObjectKeyFromObject(opts.Object), opts.Object); err != nil {
+		if err := s.read(ctx, opts.Object, opts.Subresource); err != nil {
 			return err
 		}
 	} else {
 		// Get resources
 		for _, obj := range opts.Objects {
-			if err := s.c.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if err := s.read(ctx, obj, opts.Subresource); err != nil {
 				return err
 			}
 		}
@@ -748,14 +2955,24 @@ func (s *Sawchain) GetFunc(ctx context.Context, args ...interface{}) func() erro
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndRequireImmediate(&s.opts, args...)
+	opts, err := options.ParseAndRequireImmediate(&s.opts, includeGet, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
+	// Validate scale objects
+	if opts.Subresource == string(ScaleSubresource) {
+		s.g.Expect(opts.Template).NotTo(gomega.BeEmpty(), errScaleGetNoTemplate)
+		s.requireScaleObjects(opts)
+	}
+
 	if len(opts.Template) > 0 {
 		// Render template
 		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+		s.defaultNamespace(objectRefs(unstructuredObjs)...)
 
 		// Validate objects length
 		if opts.Object != nil {
@@ -766,17 +2983,20 @@ func (s *Sawchain) GetFunc(ctx context.Context, args ...interface{}) func() erro
 
 		return func() error {
 			// Get resources
-			for _, unstructuredObj := range unstructuredObjs {
-				if err := s.c.Get(ctx, client.ObjectKeyFromObject(&unstructuredObj), &unstructuredObj); err != nil {
+			// Read into copies so subresource reads don't replace the resource identities
+			fetchedObjs := make([]unstructured.Unstructured, len(unstructuredObjs))
+			for i := range unstructuredObjs {
+				unstructuredObjs[i].DeepCopyInto(&fetchedObjs[i])
+				if err := s.read(ctx, &fetchedObjs[i], opts.Subresource); err != nil {
 					return err
 				}
 			}
 			// Save objects
 			if opts.Object != nil {
-				s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObjs[0], opts.Object)).To(gomega.Succeed(), errFailedSave)
+				s.g.Expect(util.CopyUnstructuredToObject(s.c, fetchedObjs[0], opts.Object)).To(gomega.Succeed(), errFailedSave)
 			} else if opts.Objects != nil {
-				for i, unstructuredObj := range unstructuredObjs {
-					s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObj, opts.Objects[i])).To(gomega.Succeed(), errFailedSave)
+				for i, fetchedObj := range fetchedObjs {
+					s.g.Expect(util.CopyUnstructuredToObject(s.c, fetchedObj, opts.Objects[i])).To(gomega.Succeed(), errFailedSave)
 				}
 			}
 			return nil
@@ -784,7 +3004,7 @@ func (s *Sawchain) GetFunc(ctx context.Context, args ...interface{}) func() erro
 	} else if opts.Object != nil {
 		return func() error {
 			// Get resource
-			if err := s.c.Get(ctx, client.ObjectKeyFromObject(opts.Object), opts.Object); err != nil {
+			if err := s.read(ctx, opts.Object, opts.Subresource); err != nil {
 				return err
 			}
 			return nil
@@ -793,7 +3013,7 @@ func (s *Sawchain) GetFunc(ctx context.Context, args ...interface{}) func() erro
 		return func() error {
 			// Get resources
 			for _, obj := range opts.Objects {
-				if err := s.c.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+				if err := s.read(ctx, obj, opts.Subresource); err != nil {
 					return err
 				}
 			}
@@ -810,17 +3030,26 @@ func (s *Sawchain) FetchSingle(ctx context.Context, args ...interface{}) client.
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndRequireImmediateSingle(&s.opts, args...)
+	opts, err := options.ParseAndRequireImmediateSingle(&s.opts, includeGet, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
+	// Validate scale objects
+	if opts.Subresource == string(ScaleSubresource) && len(opts.Template) > 0 {
+		s.requireScaleObjects(opts)
+	}
+
 	if len(opts.Template) > 0 {
 		// Render template
 		unstructuredObj, err := chainsaw.RenderTemplateSingle(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+		s.defaultNamespace(&unstructuredObj)
 
 		// Get resource
-		s.g.Expect(s.c.Get(ctx, client.ObjectKeyFromObject(&unstructuredObj), &unstructuredObj)).To(gomega.Succeed(), errFailedGetWithTemplate)
+		s.g.Expect(s.read(ctx, &unstructuredObj, opts.Subresource)).To(gomega.Succeed(), errFailedGetWithTemplate)
 
 		if opts.Object != nil {
 			// Save object
@@ -828,15 +3057,21 @@ func (s *Sawchain) FetchSingle(ctx context.Context, args ...interface{}) client.
 			// Return object
 			return opts.Object
 		} else {
-			// Convert to typed
-			obj, err := util.TypedFromUnstructured(s.c, unstructuredObj)
+			// Convert to typed if possible
+			obj, err := s.typedOrUnstructured(unstructuredObj)
 			s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedConvert)
 			// Return object
 			return obj
 		}
+	} else if opts.Subresource == string(ScaleSubresource) {
+		// Get subresource
+		scale, err := s.getScale(ctx, opts.Object)
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedGetWithObject)
+		// Return subresource
+		return scale
 	} else {
 		// Get resource
-		s.g.Expect(s.c.Get(ctx, client.ObjectKeyFromObject(opts.Object), opts.Object)).To(gomega.Succeed(), errFailedGetWithObject)
+		s.g.Expect(s.read(ctx, opts.Object, opts.Subresource)).To(gomega.Succeed(), errFailedGetWithObject)
 		// Return object
 		return opts.Object
 	}
@@ -848,14 +3083,18 @@ func (s *Sawchain) FetchMultiple(ctx context.Context, args ...interface{}) []cli
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndRequireImmediateMulti(&s.opts, args...)
+	opts, err := options.ParseAndRequireImmediateMulti(&s.opts, includeNone, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
 	if len(opts.Template) > 0 {
 		// Render template
 		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+		s.defaultNamespace(objectRefs(unstructuredObjs)...)
 
 		// Validate objects length
 		if opts.Objects != nil {
@@ -863,8 +3102,8 @@ func (s *Sawchain) FetchMultiple(ctx context.Context, args ...interface{}) []cli
 		}
 
 		// Get resources
-		for _, unstructuredObj := range unstructuredObjs {
-			s.g.Expect(s.c.Get(ctx, client.ObjectKeyFromObject(&unstructuredObj), &unstructuredObj)).To(gomega.Succeed(), errFailedGetWithTemplate)
+		for i := range unstructuredObjs {
+			s.g.Expect(s.c.Get(ctx, client.ObjectKeyFromObject(&unstructuredObjs[i]), &unstructuredObjs[i])).To(gomega.Succeed(), errFailedGetWithTemplate)
 		}
 
 		if opts.Objects != nil {
@@ -875,10 +3114,10 @@ func (s *Sawchain) FetchMultiple(ctx context.Context, args ...interface{}) []cli
 			// Return objects
 			return opts.Objects
 		} else {
-			// Convert to typed
+			// Convert to typed if possible
 			objs := make([]client.Object, len(unstructuredObjs))
 			for i, unstructuredObj := range unstructuredObjs {
-				obj, err := util.TypedFromUnstructured(s.c, unstructuredObj)
+				obj, err := s.typedOrUnstructured(unstructuredObj)
 				s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedConvert)
 				objs[i] = obj
 			}
@@ -901,35 +3140,53 @@ func (s *Sawchain) FetchSingleFunc(ctx context.Context, args ...interface{}) fun
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndRequireImmediateSingle(&s.opts, args...)
+	opts, err := options.ParseAndRequireImmediateSingle(&s.opts, includeGet, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
+	// Validate scale objects
+	if opts.Subresource == string(ScaleSubresource) && len(opts.Template) > 0 {
+		s.requireScaleObjects(opts)
+	}
+
 	if len(opts.Template) > 0 {
 		// Render template
 		unstructuredObj, err := chainsaw.RenderTemplateSingle(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+		s.defaultNamespace(&unstructuredObj)
 
 		return func() client.Object {
-			// Get resource
-			s.g.Expect(s.c.Get(ctx, client.ObjectKeyFromObject(&unstructuredObj), &unstructuredObj)).To(gomega.Succeed(), errFailedGetWithTemplate)
+			// Get resource (into a copy so subresource reads don't replace the resource identity)
+			fetchedObj := *unstructuredObj.DeepCopy()
+			s.g.Expect(s.read(ctx, &fetchedObj, opts.Subresource)).To(gomega.Succeed(), errFailedGetWithTemplate)
 			if opts.Object != nil {
 				// Save object
-				s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObj, opts.Object)).To(gomega.Succeed(), errFailedSave)
+				s.g.Expect(util.CopyUnstructuredToObject(s.c, fetchedObj, opts.Object)).To(gomega.Succeed(), errFailedSave)
 				// Return object
 				return opts.Object
 			} else {
-				// Convert to typed
-				obj, err := util.TypedFromUnstructured(s.c, unstructuredObj)
+				// Convert to typed if possible
+				obj, err := s.typedOrUnstructured(fetchedObj)
 				s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedConvert)
 				// Return object
 				return obj
 			}
 		}
+	} else if opts.Subresource == string(ScaleSubresource) {
+		return func() client.Object {
+			// Get subresource
+			scale, err := s.getScale(ctx, opts.Object)
+			s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedGetWithObject)
+			// Return subresource
+			return scale
+		}
 	} else {
 		return func() client.Object {
 			// Get resource
-			s.g.Expect(s.c.Get(ctx, client.ObjectKeyFromObject(opts.Object), opts.Object)).To(gomega.Succeed(), errFailedGetWithObject)
+			s.g.Expect(s.read(ctx, opts.Object, opts.Subresource)).To(gomega.Succeed(), errFailedGetWithObject)
 			// Return object
 			return opts.Object
 		}
@@ -942,14 +3199,18 @@ func (s *Sawchain) FetchMultipleFunc(ctx context.Context, args ...interface{}) f
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndRequireImmediateMulti(&s.opts, args...)
+	opts, err := options.ParseAndRequireImmediateMulti(&s.opts, includeNone, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
 	if len(opts.Template) > 0 {
 		// Render template
 		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, chainsaw.BindingsFromMap(opts.Bindings))
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+		s.defaultNamespace(objectRefs(unstructuredObjs)...)
 
 		// Validate objects length
 		if opts.Objects != nil {
@@ -958,8 +3219,8 @@ func (s *Sawchain) FetchMultipleFunc(ctx context.Context, args ...interface{}) f
 
 		return func() []client.Object {
 			// Get resources
-			for _, unstructuredObj := range unstructuredObjs {
-				s.g.Expect(s.c.Get(ctx, client.ObjectKeyFromObject(&unstructuredObj), &unstructuredObj)).To(gomega.Succeed(), errFailedGetWithTemplate)
+			for i := range unstructuredObjs {
+				s.g.Expect(s.c.Get(ctx, client.ObjectKeyFromObject(&unstructuredObjs[i]), &unstructuredObjs[i])).To(gomega.Succeed(), errFailedGetWithTemplate)
 			}
 
 			if opts.Objects != nil {
@@ -970,10 +3231,10 @@ func (s *Sawchain) FetchMultipleFunc(ctx context.Context, args ...interface{}) f
 				// Return objects
 				return opts.Objects
 			} else {
-				// Convert to typed
+				// Convert to typed if possible
 				objs := make([]client.Object, len(unstructuredObjs))
 				for i, unstructuredObj := range unstructuredObjs {
-					obj, err := util.TypedFromUnstructured(s.c, unstructuredObj)
+					obj, err := s.typedOrUnstructured(unstructuredObj)
 					s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedConvert)
 					objs[i] = obj
 				}
@@ -993,60 +3254,398 @@ func (s *Sawchain) FetchMultipleFunc(ctx context.Context, args ...interface{}) f
 	}
 }
 
-// CHECK
+// FetchList lists all resources matching a Chainsaw template or of the type of a typed object list, and
+// returns the list. Unlike FetchMultiple, resource names don't need to be known in advance, which makes
+// it useful for finding resources created by controllers (e.g. with generated names) by their labels.
+//
+// Invalid input and client errors will result in immediate test failure.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order after the context:
+//
+//   - ObjectList (client.ObjectList): Typed or unstructured list (e.g. &corev1.PodList{}) for writing the
+//     listed resources. If provided without a template, all resources of the list's type are listed
+//     (subject to list options). If provided with a template, must be a list of the template's resource
+//     type (or an empty unstructured list).
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing a single
+//     resource definition without a name. Resources of its kind matching its namespace (if any) and labels
+//     (if any) are listed. If provided without an object list, a typed list (or an unstructured list, if
+//     the type is not registered in the client scheme) is returned.
+//
+//   - Bindings (map[string]any): Bindings to be applied to a Chainsaw template (if provided) in addition to
+//     (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - List Options (client.ListOption): Options for the list request, such as client.InNamespace,
+//     client.MatchingLabels, and client.MatchingFields (field selectors). Applied after (and taking
+//     precedence over) the namespace and labels of the template.
+//
+// A template or an object list must be provided. All other arguments are optional.
+//
+// # Examples
+//
+// List the pods of a deployment by label:
+//
+//	pods := sc.FetchList(ctx, &corev1.PodList{}, client.InNamespace("default"), client.MatchingLabels{"app": "test"})
+//
+// List resources matching a Chainsaw template:
+//
+//	list := sc.FetchList(ctx, `
+//	  apiVersion: v1
+//	  kind: Pod
+//	  metadata:
+//	    namespace: ($namespace)
+//	    labels:
+//	      app: test
+//	`, map[string]any{"namespace": "default"})
+//	pods := list.(*corev1.PodList)
+//
+// List resources matching a template and a field selector into a typed list:
+//
+//	podList := &corev1.PodList{}
+//	sc.FetchList(ctx, podList, "path/to/template.yaml", client.MatchingFields{"spec.nodeName": "node-1"})
+func (s *Sawchain) FetchList(ctx context.Context, args ...interface{}) client.ObjectList {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndRequireImmediateList(&s.opts, includeList, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// List resources
+	list, listOpts := s.listTarget(ctx, opts)
+	s.g.Expect(s.c.List(ctx, list, listOpts...)).To(gomega.Succeed(), listFailureMessage(opts))
+
+	// Return list
+	return list
+}
+
+// FetchListFunc returns a function that lists all resources matching a Chainsaw template or of the type of
+// a typed object list, and returns the list. The function is suitable for polling with Gomega's Eventually
+// and Consistently (e.g. to wait for a controller to create child resources).
+//
+// Invalid input and client errors will result in immediate test failure.
+//
+// # Arguments
+//
+// Accepts the same arguments as FetchList. If an object list is provided, it is written to by each call.
+//
+// # Examples
+//
+// Wait for a deployment's pods to be created:
+//
+//	Eventually(sc.FetchListFunc(ctx, &corev1.PodList{}, client.MatchingLabels{"app": "test"})).
+//		Should(HaveField("Items", HaveLen(3)))
+func (s *Sawchain) FetchListFunc(ctx context.Context, args ...interface{}) func() client.ObjectList {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndRequireImmediateList(&s.opts, includeList, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	list, listOpts := s.listTarget(ctx, opts)
+	return func() client.ObjectList {
+		// List resources
+		s.g.Expect(s.c.List(ctx, list, listOpts...)).To(gomega.Succeed(), listFailureMessage(opts))
+		// Return list
+		return list
+	}
+}
+
+// CHECK
+
+// TODO: test
+// TODO: document
+func (s *Sawchain) Check(ctx context.Context, args ...interface{}) error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndRequireImmediateTemplate(&s.opts, includeNone, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Execute checks
+	return s.checkF(ctx, opts)()
+}
+
+// TODO: test
+// TODO: document
+func (s *Sawchain) CheckFunc(ctx context.Context, args ...interface{}) func() error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndRequireImmediateTemplate(&s.opts, includeNone, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	return s.checkF(ctx, opts)
+}
+
+// EventuallyCheck asserts that Check succeeds within a configurable duration, polling at a configurable
+// interval (defaulting to the global timeout and interval).
+//
+// Invalid input and timeout errors will result in immediate test failure.
+//
+// # Arguments
+//
+// Accepts the same arguments as Check, plus:
+//
+//   - Timeout (string or time.Duration): Optional. Defaults to global timeout (see New). The duration
+//     within which the check must succeed. If provided, must be before interval.
+//
+//   - Interval (string or time.Duration): Optional. Defaults to global interval (see New). The polling
+//     interval for checking. If provided, must be after timeout.
+//
+// # Examples
+//
+// Assert that a ConfigMap eventually has the expected data:
+//
+//	sc.EventuallyCheck(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: test-cm
+//	    namespace: default
+//	  data:
+//	    key: value
+//	`)
+//
+// Assert that a Deployment eventually becomes available with a custom timeout, saving the match:
+//
+//	deployment := &appsv1.Deployment{}
+//	sc.EventuallyCheck(ctx, deployment, "30s", `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
+//	  metadata:
+//	    name: test-deployment
+//	    namespace: default
+//	  status:
+//	    (conditions[?type == 'Available']):
+//	    - status: 'True'
+//	`)
+func (s *Sawchain) EventuallyCheck(ctx context.Context, args ...interface{}) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndRequireEventualTemplate(&s.opts, includeNone, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Wait for check to succeed
+	s.g.Eventually(s.checkF(ctx, opts), opts.Timeout, opts.Interval).Should(gomega.Succeed(), errCheckNotEventual)
+}
+
+// ConsistentlyCheck asserts that Check keeps succeeding for a configurable duration, polling at a
+// configurable interval (defaulting to the global timeout and interval).
+//
+// Invalid input and check failures will result in immediate test failure.
+//
+// # Arguments
+//
+// Accepts the same arguments as Check, plus:
+//
+//   - Timeout (string or time.Duration): Optional. Defaults to global timeout (see New). The duration
+//     for which the check must keep succeeding. If provided, must be before interval.
+//
+//   - Interval (string or time.Duration): Optional. Defaults to global interval (see New). The polling
+//     interval for checking. If provided, must be after timeout.
+//
+// # Examples
+//
+// Assert that a ConfigMap keeps its data for 10 seconds:
+//
+//	sc.ConsistentlyCheck(ctx, "10s", `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: test-cm
+//	    namespace: default
+//	  data:
+//	    key: value
+//	`)
+func (s *Sawchain) ConsistentlyCheck(ctx context.Context, args ...interface{}) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndRequireEventualTemplate(&s.opts, includeNone, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Ensure check keeps succeeding
+	s.g.Consistently(s.checkF(ctx, opts), opts.Timeout, opts.Interval).Should(gomega.Succeed(), errCheckNotConsistent)
+}
 
-// TODO: test
-// TODO: document
-func (s *Sawchain) Check(ctx context.Context, args ...interface{}) error {
+// CheckAll checks the cluster for all resources matching a single-document Chainsaw template, and returns
+// the matches (typed if possible) along with an error if the number of matches is outside the expected
+// bounds. Unlike Check, which succeeds as soon as one candidate matches, all candidates are evaluated.
+//
+// Invalid input will result in immediate test failure.
+//
+// # Arguments
+//
+//   - Template (string): Required. File path or content of a single-document Chainsaw template to match
+//     against. Candidates are listed by kind, namespace (if any), and labels (if any), or fetched by name
+//     if the template has one.
+//
+//   - Bindings (map[string]any): Optional. Bindings to be applied to the template in addition to (or
+//     overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - MatchCount (ExactMatches, MinMatches, MaxMatches, or MatchesBetween): Optional. Defaults to
+//     MinMatches(1). Bounds on the number of matching resources.
+//
+// # Examples
+//
+// Assert that exactly three Pods labeled app=web are running:
+//
+//	Expect(sc.CheckAll(ctx, `
+//	  apiVersion: v1
+//	  kind: Pod
+//	  metadata:
+//	    namespace: default
+//	    labels:
+//	      app: web
+//	  status:
+//	    phase: Running
+//	`, sawchain.ExactMatches(3))).Error().NotTo(HaveOccurred())
+//
+// Assert that there is no more than one leader Lease:
+//
+//	_, err := sc.CheckAll(ctx, leaderLeaseTemplate, sawchain.MaxMatches(1))
+//	Expect(err).NotTo(HaveOccurred())
+func (s *Sawchain) CheckAll(ctx context.Context, args ...interface{}) ([]client.Object, error) {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndRequireImmediateTemplate(&s.opts, args...)
+	opts, err := options.ParseAndRequireImmediateTemplateOnly(&s.opts, includeCheckAll, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
-	// Construct bindings
-	bindings := chainsaw.BindingsFromMap(opts.Bindings)
-
-	// Split documents
-	documents := strings.Split(opts.Template, "---")
+	// Execute check
+	return s.checkAllF(ctx, opts)()
+}
 
-	// Validate objects length
-	if opts.Object != nil {
-		s.g.Expect(documents).To(gomega.HaveLen(1), errObjectInsufficient)
-	} else if opts.Objects != nil {
-		s.g.Expect(opts.Objects).To(gomega.HaveLen(len(documents)), errObjectsWrongLength)
-	}
+// CheckAllFunc returns a function that performs CheckAll with the given arguments, for use with
+// Eventually and Consistently.
+//
+// Invalid input will result in immediate test failure.
+//
+// # Arguments
+//
+// Accepts the same arguments as CheckAll.
+//
+// # Examples
+//
+// Assert that three to five replicas eventually become ready (Eventually requires the returned error to be
+// nil in addition to satisfying the matcher):
+//
+//	Eventually(sc.CheckAllFunc(ctx, `
+//	  apiVersion: v1
+//	  kind: Pod
+//	  metadata:
+//	    namespace: default
+//	    labels:
+//	      app: web
+//	  status:
+//	    (conditions[?type == 'Ready']):
+//	    - status: 'True'
+//	`, sawchain.MatchesBetween(3, 5))).ShouldNot(BeEmpty())
+func (s *Sawchain) CheckAllFunc(ctx context.Context, args ...interface{}) func() ([]client.Object, error) {
+	s.t.Helper()
 
-	// Execute checks
-	matches := make([]unstructured.Unstructured, len(documents))
-	for i, document := range documents {
-		match, err := chainsaw.Check(s.c, ctx, document, bindings)
-		if err != nil {
-			return err
-		}
-		matches[i] = match
-	}
+	// Parse options
+	opts, err := options.ParseAndRequireImmediateTemplateOnly(&s.opts, includeCheckAll, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
-	// Save matches
-	if opts.Object != nil {
-		s.g.Expect(util.CopyUnstructuredToObject(s.c, matches[0], opts.Object)).To(gomega.Succeed(), errFailedSave)
-	} else if opts.Objects != nil {
-		for i, match := range matches {
-			s.g.Expect(util.CopyUnstructuredToObject(s.c, match, opts.Objects[i])).To(gomega.Succeed(), errFailedSave)
-		}
-	}
+	return s.checkAllF(ctx, opts)
+}
 
-	return nil
+// CheckAbsent checks that no resource in the cluster matches each document of a Chainsaw template, like a
+// Chainsaw error operation. A document is satisfied if no candidate exists (e.g. a named resource is not
+// found) or if all candidates fail to match (e.g. a templated field has a different value), and returns an
+// error identifying the matches otherwise. API errors are also returned, so they are never mistaken for
+// absence.
+//
+// Invalid input (including templates that fail to render) will result in immediate test failure.
+//
+// # Arguments
+//
+//   - Template (string): Required. File path or content of a Chainsaw template to match against.
+//
+//   - Bindings (map[string]any): Optional. Bindings to be applied to the template in addition to (or
+//     overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+// # Examples
+//
+// Assert that a ConfigMap doesn't exist:
+//
+//	Expect(sc.CheckAbsent(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: test-cm
+//	    namespace: default
+//	`)).To(Succeed())
+//
+// Assert that no Pod labeled app=web has failed:
+//
+//	Expect(sc.CheckAbsent(ctx, `
+//	  apiVersion: v1
+//	  kind: Pod
+//	  metadata:
+//	    namespace: default
+//	    labels:
+//	      app: web
+//	  status:
+//	    phase: Failed
+//	`)).To(Succeed())
+func (s *Sawchain) CheckAbsent(ctx context.Context, args ...interface{}) error {
+	s.t.Helper()
+	return s.CheckAbsentFunc(ctx, args...)()
 }
 
-// TODO: test
-// TODO: document
-func (s *Sawchain) CheckFunc(ctx context.Context, args ...interface{}) func() error {
+// CheckAbsentFunc returns a function that performs CheckAbsent with the given arguments, for use with
+// Eventually and Consistently.
+//
+// Invalid input (including templates that fail to render) will result in immediate test failure.
+//
+// # Arguments
+//
+// Accepts the same arguments as CheckAbsent.
+//
+// # Examples
+//
+// Assert that a Deployment eventually stops reporting unavailable replicas:
+//
+//	Eventually(sc.CheckAbsentFunc(ctx, `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
+//	  metadata:
+//	    name: test-deployment
+//	    namespace: default
+//	  status:
+//	    (unavailableReplicas > `0`): true
+//	`)).Should(Succeed())
+//
+// Assert that a Secret is never created:
+//
+//	Consistently(sc.CheckAbsentFunc(ctx, `
+//	  apiVersion: v1
+//	  kind: Secret
+//	  metadata:
+//	    name: test-secret
+//	    namespace: default
+//	`)).Should(Succeed())
+func (s *Sawchain) CheckAbsentFunc(ctx context.Context, args ...interface{}) func() error {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndRequireImmediateTemplate(&s.opts, args...)
+	opts, err := options.ParseAndRequireImmediateTemplateOnly(&s.opts, includeNone, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
@@ -1054,36 +3653,16 @@ func (s *Sawchain) CheckFunc(ctx context.Context, args ...interface{}) func() er
 	bindings := chainsaw.BindingsFromMap(opts.Bindings)
 
 	// Split documents
-	documents := strings.Split(opts.Template, "---")
+	documents, err := chainsaw.SplitDocuments(opts.Template)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
-	// Validate objects length
-	if opts.Object != nil {
-		s.g.Expect(documents).To(gomega.HaveLen(1), errObjectInsufficient)
-	} else if opts.Objects != nil {
-		s.g.Expect(opts.Objects).To(gomega.HaveLen(len(documents)), errObjectsWrongLength)
-	}
+	// Render expectations
+	expectations, renderErrs := renderExpectations(ctx, documents, bindings)
+	s.g.Expect(errors.Join(renderErrs...)).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 	return func() error {
 		// Execute checks
-		matches := make([]unstructured.Unstructured, len(documents))
-		for i, document := range documents {
-			match, err := chainsaw.Check(s.c, ctx, document, bindings)
-			if err != nil {
-				return err
-			}
-			matches[i] = match
-		}
-
-		// Save matches
-		if opts.Object != nil {
-			s.g.Expect(util.CopyUnstructuredToObject(s.c, matches[0], opts.Object)).To(gomega.Succeed(), errFailedSave)
-		} else if opts.Objects != nil {
-			for i, match := range matches {
-				s.g.Expect(util.CopyUnstructuredToObject(s.c, match, opts.Objects[i])).To(gomega.Succeed(), errFailedSave)
-			}
-		}
-
-		return nil
+		return s.checkAbsent(ctx, expectations, bindings)
 	}
 }
 
@@ -1150,6 +3729,78 @@ func (s *Sawchain) MatchYAML(template string, bindings ...map[string]any) types.
 	return matcher
 }
 
+// EventuallyMatch asserts that an object fetched from the cluster matches a static manifest or Chainsaw
+// template within a configurable duration, polling at a configurable interval (defaulting to the global
+// timeout and interval), and returns the object with its last fetched state.
+//
+// The object is fetched by its name and namespace on each poll and matched like with MatchYAML.
+//
+// Invalid input and timeout errors will result in immediate test failure.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Object (client.Object): Required. The object identifying the resource to fetch, which receives its
+//     fetched state.
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template to
+//     match against.
+//
+//   - Bindings (map[string]any): Optional. Bindings to be applied to the template in addition to (or
+//     overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Timeout (string or time.Duration): Optional. Defaults to global timeout (see New). The duration
+//     within which the object must match. If provided, must be before interval.
+//
+//   - Interval (string or time.Duration): Optional. Defaults to global interval (see New). The polling
+//     interval for fetching. If provided, must be after timeout.
+//
+// # Examples
+//
+// Assert that a ConfigMap eventually has the expected data:
+//
+//	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-cm", Namespace: "default"}}
+//	sc.EventuallyMatch(ctx, configMap, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  data:
+//	    key: value
+//	`)
+//
+// Assert that a Deployment eventually scales up with a custom timeout:
+//
+//	sc.EventuallyMatch(ctx, deployment, "30s", `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
+//	  status:
+//	    (readyReplicas > `1`): true
+//	`)
+func (s *Sawchain) EventuallyMatch(ctx context.Context, args ...interface{}) client.Object {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndRequireEventualObjectTemplate(&s.opts, includeNone, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Default namespaces
+	s.defaultObjectNamespaces(opts)
+
+	// Create matcher
+	matcher := matchers.NewChainsawMatcher(s.c, opts.Template, opts.Bindings)
+	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
+
+	// Wait for match
+	s.g.Eventually(func(g gomega.Gomega) {
+		g.Expect(s.get(ctx, opts.Object)).To(gomega.Succeed())
+		g.Expect(opts.Object).To(matcher)
+	}, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errMatchNotEventual)
+
+	return opts.Object
+}
+
 // TODO: test
 // HaveStatusCondition returns a Gomega matcher that uses an internal Chainsaw assertion to test if a
 // client.Object has a specific status condition.
@@ -1186,6 +3837,71 @@ func (s *Sawchain) HaveStatusCondition(conditionType, expectedStatus string) typ
 	return matcher
 }
 
+// HaveStatusReason returns a Gomega matcher that tests if an error is a K8s API status error (e.g.
+// returned by CreateExpectError or UpdateExpectError) with a specific reason.
+//
+// # Arguments
+//
+//   - Reason (metav1.StatusReason): The expected reason (e.g. metav1.StatusReasonInvalid).
+//
+// # Examples
+//
+// Check if a create request was rejected as invalid:
+//
+//	Expect(sc.CreateExpectError(ctx, template)).To(sc.HaveStatusReason(metav1.StatusReasonInvalid))
+func (s *Sawchain) HaveStatusReason(reason metav1.StatusReason) types.GomegaMatcher {
+	s.t.Helper()
+	matcher := matchers.NewStatusReasonMatcher(reason)
+	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
+	return matcher
+}
+
+// HaveStatusCode returns a Gomega matcher that tests if an error is a K8s API status error (e.g.
+// returned by CreateExpectError or UpdateExpectError) with a specific HTTP status code.
+//
+// # Arguments
+//
+//   - Code (int32): The expected HTTP status code (e.g. http.StatusForbidden).
+//
+// # Examples
+//
+// Check if a create request was denied by an admission webhook:
+//
+//	Expect(sc.CreateExpectError(ctx, template)).To(sc.HaveStatusCode(http.StatusForbidden))
+func (s *Sawchain) HaveStatusCode(code int32) types.GomegaMatcher {
+	s.t.Helper()
+	matcher := matchers.NewStatusCodeMatcher(code)
+	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
+	return matcher
+}
+
+// HaveStatusCause returns a Gomega matcher that tests if an error is a K8s API status error (e.g.
+// returned by CreateExpectError or UpdateExpectError) with a specific cause in its details.
+//
+// # Arguments
+//
+//   - Cause (metav1.StatusCause): The expected cause. Empty Type and Field values match any value, and
+//     the Message matches any cause message that contains it.
+//
+// # Examples
+//
+// Check if a field failed validation:
+//
+//	Expect(err).To(sc.HaveStatusCause(metav1.StatusCause{
+//		Type:  metav1.CauseTypeFieldValueInvalid,
+//		Field: "spec.replicas",
+//	}))
+//
+// Check if a CEL validation rule failed with a specific message:
+//
+//	Expect(err).To(sc.HaveStatusCause(metav1.StatusCause{Message: "replicas must be odd"}))
+func (s *Sawchain) HaveStatusCause(cause metav1.StatusCause) types.GomegaMatcher {
+	s.t.Helper()
+	matcher := matchers.NewStatusCauseMatcher(cause)
+	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
+	return matcher
+}
+
 // RENDER
 
 // TODO: test
@@ -1477,3 +4193,111 @@ func (s *Sawchain) RenderToFile(filepath, template string, bindings ...map[strin
 	rendered := s.RenderToString(template, bindings...)
 	s.g.Expect(os.WriteFile(filepath, []byte(rendered), 0644)).To(gomega.Succeed(), errFailedWrite)
 }
+
+// GENERICS
+
+// newTyped returns a new zero value of the struct type pointed to by T, failing the test if T is not a
+// pointer to a struct type.
+func newTyped[T any](s *Sawchain) T {
+	s.t.Helper()
+	var zero T
+	typ := reflect.TypeOf(zero)
+	s.g.Expect(typ != nil && typ.Kind() == reflect.Ptr && typ.Elem().Kind() == reflect.Struct).To(
+		gomega.BeTrue(), errTypeNotPointer)
+	return reflect.New(typ.Elem()).Interface().(T)
+}
+
+// asTyped returns the value as T, failing the test with a readable message if it has a different type.
+func asTyped[T any](s *Sawchain, v any) T {
+	s.t.Helper()
+	typed, ok := v.(T)
+	s.g.Expect(ok).To(gomega.BeTrue(), fmt.Sprintf("%s: expected %T but got %T", errWrongType, typed, v))
+	return typed
+}
+
+// Fetch is a generic form of FetchSingle that returns the resource as type T (e.g. *appsv1.Deployment)
+// instead of client.Object, so no type assertion is needed.
+//
+// Invalid input, client errors, and type mismatches will result in immediate test failure.
+//
+// # Arguments
+//
+// Accepts the same arguments as FetchSingle after the Sawchain instance and context. If no object is
+// provided, a new object of type T is used to save the resource's state. If an object is provided, the
+// value returned by FetchSingle (e.g. the scale object when using ScaleSubresource) must be of type T.
+//
+// # Examples
+//
+// Fetch a typed resource with a Chainsaw template:
+//
+//	deployment := sawchain.Fetch[*appsv1.Deployment](sc, ctx, `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
+//	  metadata:
+//	    name: test-deployment
+//	    namespace: default
+//	`)
+//	Expect(deployment.Status.ReadyReplicas).To(Equal(int32(1)))
+//
+// Fetch the scale subresource of a resource:
+//
+//	scale := sawchain.Fetch[*autoscalingv1.Scale](sc, ctx, deployment, sawchain.ScaleSubresource)
+func Fetch[T client.Object](s *Sawchain, ctx context.Context, args ...interface{}) T {
+	s.t.Helper()
+	for _, arg := range args {
+		if _, ok := util.AsObject(arg); ok {
+			return asTyped[T](s, s.FetchSingle(ctx, args...))
+		}
+	}
+	obj := newTyped[T](s)
+	return asTyped[T](s, s.FetchSingle(ctx, append([]interface{}{obj}, args...)...))
+}
+
+// FetchList is a generic form of Sawchain.FetchList that returns the list as type T (e.g.
+// *corev1.PodList) instead of client.ObjectList, so no type assertion is needed.
+//
+// Invalid input, client errors, and type mismatches will result in immediate test failure.
+//
+// # Arguments
+//
+// Accepts the same arguments as Sawchain.FetchList after the Sawchain instance and context. If no object
+// list is provided, a new list of type T is used to save the listed resources.
+//
+// # Examples
+//
+// List the pods of a deployment by label:
+//
+//	pods := sawchain.FetchList[*corev1.PodList](sc, ctx, client.MatchingLabels{"app": "test"})
+//	Expect(pods.Items).To(HaveLen(3))
+func FetchList[T client.ObjectList](s *Sawchain, ctx context.Context, args ...interface{}) T {
+	s.t.Helper()
+	for _, arg := range args {
+		if _, ok := util.AsObjectList(arg); ok {
+			return asTyped[T](s, s.FetchList(ctx, args...))
+		}
+	}
+	list := newTyped[T](s)
+	return asTyped[T](s, s.FetchList(ctx, append([]interface{}{list}, args...)...))
+}
+
+// Render is a generic form of RenderToObject that renders a Chainsaw template with optional bindings into
+// a new object of type T (e.g. *corev1.ConfigMap) and returns it.
+//
+// Invalid input will result in immediate test failure.
+//
+// # Arguments
+//
+// Accepts the same arguments as RenderToObject after the Sawchain instance, except for the object.
+//
+// # Examples
+//
+// Render a typed resource from a template file using bindings:
+//
+//	configMap := sawchain.Render[*corev1.ConfigMap](sc, "path/to/template.yaml",
+//	  map[string]any{"name": "test-cm", "namespace": "default"})
+func Render[T client.Object](s *Sawchain, template string, bindings ...map[string]any) T {
+	s.t.Helper()
+	obj := newTyped[T](s)
+	s.RenderToObject(obj, template, bindings...)
+	return obj
+}
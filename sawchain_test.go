@@ -2,28 +2,54 @@ package sawchain_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/eolatham/sawchain"
 	"github.com/eolatham/sawchain/internal/testutil"
 	"github.com/eolatham/sawchain/internal/util"
 )
 
-// MockT allows capturing failures and error logs.
+// MockT allows capturing failures, error logs, and cleanup functions.
 type MockT struct {
 	testing.TB
 	failed    bool
 	ErrorLogs []string
+	cleanups  []func()
+}
+
+func (m *MockT) Cleanup(f func()) {
+	m.cleanups = append(m.cleanups, f)
+}
+
+// RunCleanups runs registered cleanup functions in last-added, first-called order.
+func (m *MockT) RunCleanups() {
+	for i := len(m.cleanups) - 1; i >= 0; i-- {
+		m.cleanups[i]()
+	}
+	m.cleanups = nil
 }
 
 func (m *MockT) Failed() bool {
@@ -58,21 +84,65 @@ func (m *MockT) Fatalf(format string, args ...interface{}) {
 	runtime.Goexit()
 }
 
+// runOp runs the operation like a test would, allowing it to fail the mock test.
+func runOp(operation func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		operation()
+	}()
+	<-done
+}
+
+// newMockSawchain creates a Sawchain instance with the given client and arguments that reports failures
+// to a new MockT.
+func newMockSawchain(c client.Client, args ...interface{}) (*MockT, *sawchain.Sawchain) {
+	t := &MockT{TB: GinkgoTB()}
+	return t, sawchain.New(t, c, args...)
+}
+
+// withFinalizers adds finalizers to the object and returns it.
+func withFinalizers[T client.Object](obj T, finalizers ...string) T {
+	obj.SetFinalizers(finalizers)
+	return obj
+}
+
+// withLabels adds labels to the object and returns it.
+func withLabels[T client.Object](obj T, labels map[string]string) T {
+	obj.SetLabels(labels)
+	return obj
+}
+
 // MockClient allows simulating K8s API failures.
 type MockClient struct {
 	client.Client
 
-	getFailFirstN int
-	getCallCount  int
+	getFailFirstN     int
+	getNotFoundFirstN int
+	getCallCount      int
 
 	createFailFirstN int
 	createCallCount  int
+	createRejection  error
+	createdNames     []string
+
+	// If set, created CRDs are established and their kinds are added to this RESTMapper.
+	crdMapper *meta.DefaultRESTMapper
 
-	updateFailFirstN int
-	updateCallCount  int
+	updateFailFirstN     int
+	updateConflictFirstN int
+	updateCallCount      int
 
-	deleteFailFirstN int
-	deleteCallCount  int
+	deleteFailFirstN  int
+	deleteCallCount   int
+	lastDeleteOptions *client.DeleteOptions
+	deletedNames      []string
+	// If set, these objects are created after the first delete, like a controller replacing resources.
+	deleteRecreates []client.Object
+
+	patchFailFirstN  int
+	patchCallCount   int
+	lastPatchOptions *client.PatchOptions
 }
 
 func (m *MockClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
@@ -80,6 +150,9 @@ func (m *MockClient) Get(ctx context.Context, key client.ObjectKey, obj client.O
 	if m.getFailFirstN < 0 || m.getCallCount <= m.getFailFirstN {
 		return fmt.Errorf("simulated get failure")
 	}
+	if m.getCallCount <= m.getNotFoundFirstN {
+		return apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, key.Name)
+	}
 	return m.Client.Get(ctx, key, obj, opts...)
 }
 
@@ -88,7 +161,41 @@ func (m *MockClient) Create(ctx context.Context, obj client.Object, opts ...clie
 	if m.createFailFirstN < 0 || m.createCallCount <= m.createFailFirstN {
 		return fmt.Errorf("simulated create failure")
 	}
-	return m.Client.Create(ctx, obj, opts...)
+	if m.createRejection != nil {
+		return m.createRejection
+	}
+	if err := m.Client.Create(ctx, obj, opts...); err != nil {
+		return err
+	}
+	m.createdNames = append(m.createdNames, obj.GetName())
+	if m.crdMapper != nil && obj.GetObjectKind().GroupVersionKind().Kind == "CustomResourceDefinition" {
+		return m.establishCRD(ctx, obj)
+	}
+	return nil
+}
+
+// establishCRD simulates an API server establishing a CRD and serving its kind.
+func (m *MockClient) establishCRD(ctx context.Context, obj client.Object) error {
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+	if err := m.Client.Get(ctx, client.ObjectKeyFromObject(obj), crd); err != nil {
+		return err
+	}
+	conditions := []any{map[string]any{"type": "Established", "status": "True"}}
+	if err := unstructured.SetNestedSlice(crd.Object, conditions, "status", "conditions"); err != nil {
+		return err
+	}
+	if err := m.Client.Status().Update(ctx, crd); err != nil {
+		return err
+	}
+	group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+	kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, version := range versions {
+		name, _, _ := unstructured.NestedString(version.(map[string]any), "name")
+		m.crdMapper.Add(schema.GroupVersionKind{Group: group, Version: name, Kind: kind}, meta.RESTScopeNamespace)
+	}
+	return nil
 }
 
 func (m *MockClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
@@ -96,15 +203,85 @@ func (m *MockClient) Update(ctx context.Context, obj client.Object, opts ...clie
 	if m.updateFailFirstN < 0 || m.updateCallCount <= m.updateFailFirstN {
 		return fmt.Errorf("simulated update failure")
 	}
+	if m.updateConflictFirstN < 0 || m.updateCallCount <= m.updateConflictFirstN {
+		return apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, obj.GetName(), fmt.Errorf("simulated conflict"))
+	}
 	return m.Client.Update(ctx, obj, opts...)
 }
 
 func (m *MockClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
 	m.deleteCallCount++
+	m.lastDeleteOptions = (&client.DeleteOptions{}).ApplyOptions(opts)
 	if m.deleteFailFirstN < 0 || m.deleteCallCount <= m.deleteFailFirstN {
 		return fmt.Errorf("simulated delete failure")
 	}
-	return m.Client.Delete(ctx, obj, opts...)
+	m.deletedNames = append(m.deletedNames, obj.GetName())
+	if err := m.Client.Delete(ctx, obj, opts...); err != nil {
+		return err
+	}
+	for _, recreated := range m.deleteRecreates {
+		if err := m.Client.Create(ctx, recreated); err != nil {
+			return err
+		}
+	}
+	m.deleteRecreates = nil
+	return nil
+}
+
+func (m *MockClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	m.patchCallCount++
+	m.lastPatchOptions = (&client.PatchOptions{}).ApplyOptions(opts)
+	if m.patchFailFirstN < 0 || m.patchCallCount <= m.patchFailFirstN {
+		return fmt.Errorf("simulated patch failure")
+	}
+	if patch.Type() == types.ApplyPatchType {
+		// Simulate server-side apply, which the fake client does not support
+		existing := obj.DeepCopyObject().(client.Object)
+		if err := m.Client.Get(ctx, client.ObjectKeyFromObject(obj), existing); apierrors.IsNotFound(err) {
+			return m.Client.Create(ctx, obj)
+		} else if err != nil {
+			return err
+		}
+		return m.Client.Update(ctx, obj)
+	}
+	return m.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func (m *MockClient) SubResource(subResource string) client.SubResourceClient {
+	if subResource == "scale" {
+		return &MockScaleClient{SubResourceClient: m.Client.SubResource(subResource)}
+	}
+	return m.Client.SubResource(subResource)
+}
+
+// MockScaleClient simulates scale subresource merge patches, which the fake client does not support.
+type MockScaleClient struct {
+	client.SubResourceClient
+}
+
+func (m *MockScaleClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	patchOpts := (&client.SubResourcePatchOptions{}).ApplyOptions(opts)
+	scale := patchOpts.SubResourceBody.(*autoscalingv1.Scale)
+	if err := m.Get(ctx, obj, scale); err != nil {
+		return err
+	}
+	data, err := patch.Data(scale)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, scale); err != nil {
+		return err
+	}
+	updateOpts := &client.SubResourceUpdateOptions{
+		UpdateOptions:   client.UpdateOptions{DryRun: patchOpts.DryRun},
+		SubResourceBody: scale,
+	}
+	if err := m.Update(ctx, obj, updateOpts); err != nil {
+		return err
+	}
+	// Write the result to the Scale like an API server
+	scale.ResourceVersion = obj.GetResourceVersion()
+	return nil
 }
 
 var _ = Describe("Sawchain", func() {
@@ -881,4 +1058,2860 @@ data:
 			}),
 		)
 	})
+
+	Describe("Create cleanup", func() {
+		type testCase struct {
+			client                 client.Client
+			globalArgs             []interface{}
+			methodArgs             []interface{}
+			deleteBeforeCleanup    bool
+			expectedDeletedObjects []client.Object
+			expectedKeptObjects    []client.Object
+		}
+		DescribeTable("cleaning up created test resources",
+			func(tc testCase) {
+				// Create Sawchain
+				t, sc := newMockSawchain(tc.client, append([]interface{}{fastTimeout, fastInterval}, tc.globalArgs...)...)
+
+				// Test Create
+				runOp(func() { sc.Create(ctx, tc.methodArgs...) })
+				Expect(t.Failed()).To(BeFalse(), "expected Create to succeed: %v", t.ErrorLogs)
+
+				// Simulate deletion by test
+				if tc.deleteBeforeCleanup {
+					for _, obj := range tc.expectedDeletedObjects {
+						Expect(tc.client.Delete(ctx, obj)).To(Succeed())
+					}
+				}
+
+				// Run cleanups
+				runOp(t.RunCleanups)
+				Expect(t.Failed()).To(BeFalse(), "expected cleanup to succeed: %v", t.ErrorLogs)
+
+				// Verify resources
+				for _, obj := range tc.expectedDeletedObjects {
+					err := tc.client.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+					Expect(apierrors.IsNotFound(err)).To(BeTrue(),
+						"expected cleanup to delete resource: %s", client.ObjectKeyFromObject(obj))
+				}
+				for _, obj := range tc.expectedKeptObjects {
+					Expect(tc.client.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed(),
+						"expected cleanup to keep resource: %s", client.ObjectKeyFromObject(obj))
+				}
+			},
+
+			Entry("should delete resource created with object", testCase{
+				client: testutil.NewStandardFakeClient(),
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				expectedDeletedObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", nil),
+				},
+			}),
+
+			Entry("should delete resources created with objects", testCase{
+				client: testutil.NewStandardFakeClient(),
+				methodArgs: []interface{}{
+					[]client.Object{
+						testutil.NewConfigMap("test-cm1", "default", map[string]string{"key1": "value1"}),
+						testutil.NewUnstructuredConfigMap("test-cm2", "default", map[string]string{"key2": "value2"}),
+					},
+				},
+				expectedDeletedObjects: []client.Object{
+					testutil.NewConfigMap("test-cm1", "default", nil),
+					testutil.NewConfigMap("test-cm2", "default", nil),
+				},
+			}),
+
+			Entry("should delete resources created with template", testCase{
+				client: testutil.NewStandardFakeClient(),
+				methodArgs: []interface{}{
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm1
+  namespace: default
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm2
+  namespace: default
+`,
+				},
+				expectedDeletedObjects: []client.Object{
+					testutil.NewConfigMap("test-cm1", "default", nil),
+					testutil.NewConfigMap("test-cm2", "default", nil),
+				},
+			}),
+
+			Entry("should ignore resource already deleted by test", testCase{
+				client: testutil.NewStandardFakeClient(),
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				deleteBeforeCleanup: true,
+				expectedDeletedObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", nil),
+				},
+			}),
+
+			Entry("should keep resource when cleanup is skipped for operation", testCase{
+				client: testutil.NewStandardFakeClient(),
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+					sawchain.SkipCleanup,
+				},
+				expectedKeptObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", nil),
+				},
+			}),
+
+			Entry("should keep resources when cleanup is skipped globally", testCase{
+				client:     testutil.NewStandardFakeClient(),
+				globalArgs: []interface{}{sawchain.SkipCleanup},
+				methodArgs: []interface{}{
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+`,
+				},
+				expectedKeptObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", nil),
+				},
+			}),
+		)
+	})
+
+	Describe("Upsert", func() {
+		type testCase struct {
+			client           *MockClient
+			existingObjects  []client.Object
+			methodArgs       []interface{}
+			expectedErrs     []string
+			expectedObjects  []client.Object
+			expectedCleanups int
+		}
+		DescribeTable("upserting test resources",
+			func(tc testCase) {
+				// Create Sawchain
+				t, sc := newMockSawchain(tc.client, fastTimeout, fastInterval)
+
+				// Create existing resources
+				for _, obj := range tc.existingObjects {
+					Expect(tc.client.Client.Create(ctx, obj)).To(Succeed())
+				}
+
+				// Test Upsert
+				runOp(func() { sc.Upsert(ctx, tc.methodArgs...) })
+
+				if len(tc.expectedErrs) > 0 {
+					// Verify failure
+					Expect(t.Failed()).To(BeTrue(), "expected Upsert to fail")
+					for _, expectedErr := range tc.expectedErrs {
+						Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedErr)))
+					}
+					return
+				}
+				Expect(t.Failed()).To(BeFalse(), "expected Upsert to succeed: %v", t.ErrorLogs)
+
+				// Verify resource states
+				for _, expectedObject := range tc.expectedObjects {
+					actual := &corev1.ConfigMap{}
+					Expect(tc.client.Get(ctx, client.ObjectKeyFromObject(expectedObject), actual)).To(Succeed())
+					Expect(actual.Data).To(Equal(expectedObject.(*corev1.ConfigMap).Data))
+				}
+
+				// Verify saved state
+				for _, arg := range tc.methodArgs {
+					if obj, ok := util.AsObject(arg); ok {
+						Expect(obj.GetResourceVersion()).NotTo(BeEmpty(), "expected Upsert to save state to provided object")
+					}
+				}
+
+				// Verify only created resources are cleaned up
+				Expect(t.cleanups).To(HaveLen(tc.expectedCleanups))
+			},
+
+			Entry("should create new resource with typed object", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				expectedObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				expectedCleanups: 1,
+			}),
+
+			Entry("should update existing resource with template and save to object", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				methodArgs: []interface{}{
+					&corev1.ConfigMap{},
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ($name)
+  namespace: default
+data:
+  key: updated-value
+`,
+					map[string]any{"name": "test-cm"},
+				},
+				expectedObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "updated-value"}),
+				},
+				expectedCleanups: 0,
+			}),
+
+			Entry("should create and update multiple resources with objects", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm1", "default", map[string]string{"key1": "value1"}),
+				},
+				methodArgs: []interface{}{
+					[]client.Object{
+						testutil.NewConfigMap("test-cm1", "default", map[string]string{"key1": "updated-value1"}),
+						testutil.NewUnstructuredConfigMap("test-cm2", "default", map[string]string{"key2": "value2"}),
+					},
+				},
+				expectedObjects: []client.Object{
+					testutil.NewConfigMap("test-cm1", "default", map[string]string{"key1": "updated-value1"}),
+					testutil.NewConfigMap("test-cm2", "default", map[string]string{"key2": "value2"}),
+				},
+				expectedCleanups: 1,
+			}),
+
+			Entry("should not clean up created resources with skip cleanup", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+					sawchain.SkipCleanup,
+				},
+				expectedObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				expectedCleanups: 0,
+			}),
+
+			Entry("should fail when create fails", testCase{
+				client: &MockClient{
+					Client:           testutil.NewStandardFakeClient(),
+					createFailFirstN: 1,
+				},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				expectedErrs: []string{"failed to upsert with object", "simulated create failure"},
+			}),
+
+			Entry("should fail when update fails", testCase{
+				client: &MockClient{
+					Client:           testutil.NewStandardFakeClient(),
+					updateFailFirstN: 1,
+				},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				methodArgs: []interface{}{
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+data:
+  key: updated-value
+`,
+				},
+				expectedErrs: []string{"failed to upsert with template", "simulated update failure"},
+			}),
+
+			Entry("should fail when lookup fails", testCase{
+				client: &MockClient{
+					Client:        testutil.NewStandardFakeClient(),
+					getFailFirstN: 1,
+				},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				expectedErrs: []string{"failed to upsert with object", "simulated get failure"},
+			}),
+		)
+
+		It("should update resources created since the lookup", func() {
+			c := &MockClient{Client: testutil.NewStandardFakeClient(), getNotFoundFirstN: 1}
+			Expect(c.Client.Create(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}))).To(Succeed())
+			t, sc := newMockSawchain(c, fastTimeout, fastInterval)
+			runOp(func() {
+				sc.Upsert(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "updated"}))
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Upsert to succeed: %v", t.ErrorLogs)
+			actual := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "test-cm", Namespace: "default"}, actual)).To(Succeed())
+			Expect(actual.Data).To(Equal(map[string]string{"key": "updated"}))
+			Expect(t.cleanups).To(BeEmpty(), "expected updated resource not to be cleaned up")
+		})
+
+		It("should create resources with generated names in dependency order", func() {
+			c := &MockClient{Client: testutil.NewStandardFakeClient()}
+			t, sc := newMockSawchain(c, fastTimeout, fastInterval)
+			runOp(func() {
+				sc.Upsert(ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  generateName: test-
+  namespace: test-ns
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: test-ns
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Upsert to succeed: %v", t.ErrorLogs)
+			Expect(c.createdNames).To(HaveLen(2))
+			Expect(c.createdNames[0]).To(Equal("test-ns"))
+			Expect(c.createdNames[1]).To(HavePrefix("test-"))
+			Expect(t.cleanups).To(HaveLen(2))
+
+			// Generated names are bound for later operations
+			runOp(func() {
+				Expect(sc.Check(ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ($generatedNames."test-")
+  namespace: test-ns
+`)).To(Succeed())
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Check to succeed: %v", t.ErrorLogs)
+		})
+	})
+
+	Describe("Apply", func() {
+		type testCase struct {
+			client                 *MockClient
+			existingObjects        []client.Object
+			methodArgs             []interface{}
+			expectedErrs           []string
+			expectedObjects        []client.Object
+			expectedFieldManager   string
+			expectedForceOwnership bool
+		}
+		DescribeTable("applying test resources",
+			func(tc testCase) {
+				// Create Sawchain
+				t, sc := newMockSawchain(tc.client, fastTimeout, fastInterval)
+
+				// Create existing resources
+				for _, obj := range tc.existingObjects {
+					Expect(tc.client.Client.Create(ctx, obj)).To(Succeed())
+				}
+
+				// Test Apply
+				runOp(func() { sc.Apply(ctx, tc.methodArgs...) })
+
+				if len(tc.expectedErrs) > 0 {
+					// Verify failure
+					Expect(t.Failed()).To(BeTrue(), "expected Apply to fail")
+					for _, expectedErr := range tc.expectedErrs {
+						Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedErr)))
+					}
+					return
+				}
+				Expect(t.Failed()).To(BeFalse(), "expected Apply to succeed: %v", t.ErrorLogs)
+
+				// Verify patch options
+				Expect(tc.client.lastPatchOptions.FieldManager).To(Equal(tc.expectedFieldManager))
+				if tc.expectedForceOwnership {
+					Expect(tc.client.lastPatchOptions.Force).NotTo(BeNil())
+					Expect(*tc.client.lastPatchOptions.Force).To(BeTrue())
+				} else {
+					Expect(tc.client.lastPatchOptions.Force).To(BeNil())
+				}
+
+				// Verify resource states
+				for _, expectedObject := range tc.expectedObjects {
+					actual := &corev1.ConfigMap{}
+					Expect(tc.client.Get(ctx, client.ObjectKeyFromObject(expectedObject), actual)).To(Succeed())
+					Expect(actual.Data).To(Equal(expectedObject.(*corev1.ConfigMap).Data))
+				}
+
+				// Verify saved state
+				for _, arg := range tc.methodArgs {
+					if obj, ok := util.AsObject(arg); ok {
+						Expect(obj.GetResourceVersion()).NotTo(BeEmpty(), "expected Apply to save applied state to provided object")
+					}
+				}
+			},
+
+			Entry("should apply new resource with typed object", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				expectedObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				expectedFieldManager: sawchain.DefaultFieldManager,
+			}),
+
+			Entry("should apply existing resource with template and save to object", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				methodArgs: []interface{}{
+					&corev1.ConfigMap{},
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ($name)
+  namespace: default
+data:
+  key: applied-value
+`,
+					map[string]any{"name": "test-cm"},
+				},
+				expectedObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "applied-value"}),
+				},
+				expectedFieldManager: sawchain.DefaultFieldManager,
+			}),
+
+			Entry("should apply multiple resources with custom field manager and force", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				methodArgs: []interface{}{
+					[]client.Object{
+						testutil.NewConfigMap("test-cm1", "default", map[string]string{"key1": "value1"}),
+						testutil.NewUnstructuredConfigMap("test-cm2", "default", map[string]string{"key2": "value2"}),
+					},
+					client.FieldOwner("test-manager"),
+					client.ForceOwnership,
+				},
+				expectedObjects: []client.Object{
+					testutil.NewConfigMap("test-cm1", "default", map[string]string{"key1": "value1"}),
+					testutil.NewConfigMap("test-cm2", "default", map[string]string{"key2": "value2"}),
+				},
+				expectedFieldManager:   "test-manager",
+				expectedForceOwnership: true,
+			}),
+
+			Entry("should fail when apply fails with object", testCase{
+				client: &MockClient{
+					Client:          testutil.NewStandardFakeClient(),
+					patchFailFirstN: 1,
+				},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				expectedErrs: []string{"failed to apply with object", "simulated patch failure"},
+			}),
+
+			Entry("should fail when apply fails with template", testCase{
+				client: &MockClient{
+					Client:          testutil.NewStandardFakeClient(),
+					patchFailFirstN: 1,
+				},
+				methodArgs: []interface{}{
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+`,
+				},
+				expectedErrs: []string{"failed to apply with template", "simulated patch failure"},
+			}),
+
+			Entry("should fail when get fails indefinitely after apply", testCase{
+				client: &MockClient{
+					Client:        testutil.NewStandardFakeClient(),
+					getFailFirstN: -1,
+				},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				expectedErrs: []string{"client cache not synced within timeout"},
+			}),
+		)
+	})
+
+	Describe("Patch", func() {
+		type testCase struct {
+			client          *MockClient
+			existingObjects []client.Object
+			methodArgs      []interface{}
+			expectedErrs    []string
+			expectedObjects []client.Object
+		}
+		DescribeTable("patching test resources",
+			func(tc testCase) {
+				// Create Sawchain
+				t, sc := newMockSawchain(tc.client, fastTimeout, fastInterval)
+
+				// Create existing resources
+				for _, obj := range tc.existingObjects {
+					Expect(tc.client.Client.Create(ctx, obj)).To(Succeed())
+				}
+
+				// Test Patch
+				runOp(func() { sc.Patch(ctx, tc.methodArgs...) })
+
+				if len(tc.expectedErrs) > 0 {
+					// Verify failure
+					Expect(t.Failed()).To(BeTrue(), "expected Patch to fail")
+					for _, expectedErr := range tc.expectedErrs {
+						Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedErr)))
+					}
+					return
+				}
+				Expect(t.Failed()).To(BeFalse(), "expected Patch to succeed: %v", t.ErrorLogs)
+
+				// Verify resource states
+				for _, expectedObject := range tc.expectedObjects {
+					actual := &corev1.ConfigMap{}
+					Expect(tc.client.Get(ctx, client.ObjectKeyFromObject(expectedObject), actual)).To(Succeed())
+					Expect(actual.Data).To(Equal(expectedObject.(*corev1.ConfigMap).Data))
+				}
+
+				// Verify saved states
+				for _, arg := range tc.methodArgs {
+					if obj, ok := util.AsObject(arg); ok {
+						Expect(obj).To(HaveField("Data", tc.expectedObjects[0].(*corev1.ConfigMap).Data),
+							"expected Patch to save patched state to provided object")
+					}
+				}
+			},
+
+			Entry("should merge patch resource with template", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "value1", "key2": "value2"}),
+				},
+				methodArgs: []interface{}{
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ($name)
+  namespace: default
+data:
+  key1: ($value)
+`,
+					map[string]any{"name": "test-cm", "value": "patched"},
+				},
+				expectedObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "patched", "key2": "value2"}),
+				},
+			}),
+
+			Entry("should strategic merge patch resource with template and save to typed object", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "value1", "key2": "value2"}),
+				},
+				methodArgs: []interface{}{
+					&corev1.ConfigMap{},
+					types.StrategicMergePatchType,
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+data:
+  key2: patched
+`,
+				},
+				expectedObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "value1", "key2": "patched"}),
+				},
+			}),
+
+			Entry("should merge patch multiple resources with template", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm1", "default", map[string]string{"key": "value1"}),
+					testutil.NewConfigMap("test-cm2", "default", map[string]string{"key": "value2"}),
+				},
+				methodArgs: []interface{}{
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm1
+  namespace: default
+data:
+  key: null
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm2
+  namespace: default
+data:
+  other: added
+`,
+				},
+				expectedObjects: []client.Object{
+					testutil.NewConfigMap("test-cm1", "default", nil),
+					testutil.NewConfigMap("test-cm2", "default", map[string]string{"key": "value2", "other": "added"}),
+				},
+			}),
+
+			Entry("should JSON patch resource identified by typed object", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "value1", "key2": "value2"}),
+				},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", nil),
+					types.JSONPatchType,
+					`
+- op: replace
+  path: /data/key1
+  value: ($value)
+- op: remove
+  path: /data/key2
+`,
+					map[string]any{"value": "patched"},
+				},
+				expectedObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "patched"}),
+				},
+			}),
+
+			Entry("should fail with JSON patch and no object", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				methodArgs: []interface{}{
+					types.JSONPatchType,
+					`
+- op: remove
+  path: /data/key
+`,
+				},
+				expectedErrs: []string{"JSON patch requires an object or objects"},
+			}),
+
+			Entry("should fail with unsupported patch type", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				methodArgs: []interface{}{
+					types.ApplyPatchType,
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+`,
+				},
+				expectedErrs: []string{"patch type must be merge, strategic merge, or JSON patch"},
+			}),
+
+			Entry("should fail with no template", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", nil),
+				},
+				expectedErrs: []string{"invalid arguments", "required argument(s) not provided: Template (string)"},
+			}),
+
+			Entry("should fail when resource does not exist", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				methodArgs: []interface{}{
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+data:
+  key: value
+`,
+				},
+				expectedErrs: []string{"failed to patch with template", "not found"},
+			}),
+
+			Entry("should fail when patch fails with object", testCase{
+				client: &MockClient{
+					Client:          testutil.NewStandardFakeClient(),
+					patchFailFirstN: 1,
+				},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", nil),
+					types.JSONPatchType,
+					`
+- op: remove
+  path: /data/key
+`,
+				},
+				expectedErrs: []string{"failed to patch with object", "simulated patch failure"},
+			}),
+		)
+	})
+
+	Describe("Mutate", func() {
+		type testCase struct {
+			client              *MockClient
+			existingObjects     []client.Object
+			methodArgs          []interface{}
+			expectedErrs        []string
+			expectedData        map[string]string
+			expectedUpdateCalls int
+		}
+		DescribeTable("mutating test resources",
+			func(tc testCase) {
+				// Create Sawchain
+				t, sc := newMockSawchain(tc.client, fastTimeout, fastInterval)
+
+				// Create existing resources
+				for _, obj := range tc.existingObjects {
+					Expect(tc.client.Client.Create(ctx, obj)).To(Succeed())
+					// Make the resource version of the provided object stale
+					Expect(tc.client.Client.Update(ctx, obj.DeepCopyObject().(client.Object))).To(Succeed())
+				}
+
+				// Test Mutate
+				runOp(func() { sc.Mutate(ctx, tc.methodArgs...) })
+
+				if tc.expectedUpdateCalls > 0 {
+					Expect(tc.client.updateCallCount).To(Equal(tc.expectedUpdateCalls))
+				}
+
+				if len(tc.expectedErrs) > 0 {
+					// Verify failure
+					Expect(t.Failed()).To(BeTrue(), "expected Mutate to fail")
+					for _, expectedErr := range tc.expectedErrs {
+						Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedErr)))
+					}
+					return
+				}
+				Expect(t.Failed()).To(BeFalse(), "expected Mutate to succeed: %v", t.ErrorLogs)
+
+				// Verify resource state
+				actual := &corev1.ConfigMap{}
+				Expect(tc.client.Get(ctx, types.NamespacedName{Name: "test-cm", Namespace: "default"}, actual)).To(Succeed())
+				Expect(actual.Data).To(Equal(tc.expectedData))
+
+				// Verify saved state
+				for _, arg := range tc.methodArgs {
+					if obj, ok := util.AsObject(arg); ok {
+						Expect(obj.GetResourceVersion()).To(Equal(actual.ResourceVersion),
+							"expected Mutate to save final state to provided object")
+					}
+				}
+			},
+
+			Entry("should mutate resource with stale typed object and typed function", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+					func(cm *corev1.ConfigMap) {
+						cm.Data["key"] = "mutated-value"
+					},
+				},
+				expectedData:        map[string]string{"key": "mutated-value"},
+				expectedUpdateCalls: 1,
+			}),
+
+			Entry("should mutate resource with partial template", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "value1"}),
+				},
+				methodArgs: []interface{}{
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+data:
+  key2: ($value)
+`,
+					map[string]any{"value": "value2"},
+				},
+				expectedData: map[string]string{"key1": "value1", "key2": "value2"},
+			}),
+
+			Entry("should mutate resource with template and function", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "value1"}),
+				},
+				methodArgs: []interface{}{
+					&corev1.ConfigMap{},
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+data:
+  key2: value2
+`,
+					func(obj client.Object) error {
+						cm, ok := obj.(*corev1.ConfigMap)
+						if !ok {
+							return fmt.Errorf("unexpected type %T", obj)
+						}
+						delete(cm.Data, "key1")
+						return nil
+					},
+				},
+				expectedData: map[string]string{"key2": "value2"},
+			}),
+
+			Entry("should retry on conflict", testCase{
+				client: &MockClient{
+					Client:               testutil.NewStandardFakeClient(),
+					updateConflictFirstN: 2,
+				},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				methodArgs: []interface{}{
+					testutil.NewUnstructuredConfigMap("test-cm", "default", nil),
+					func(obj *unstructured.Unstructured) error {
+						return unstructured.SetNestedField(obj.Object, "mutated-value", "data", "key")
+					},
+				},
+				expectedData:        map[string]string{"key": "mutated-value"},
+				expectedUpdateCalls: 3,
+			}),
+
+			Entry("should fail when conflicts persist", testCase{
+				client: &MockClient{
+					Client:               testutil.NewStandardFakeClient(),
+					updateConflictFirstN: -1,
+				},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", nil),
+					func(client.Object) {},
+				},
+				expectedErrs: []string{"failed to mutate", "simulated conflict"},
+			}),
+
+			Entry("should fail without retrying when update fails", testCase{
+				client: &MockClient{
+					Client:           testutil.NewStandardFakeClient(),
+					updateFailFirstN: 1,
+				},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", nil),
+					func(client.Object) {},
+				},
+				expectedErrs:        []string{"failed to mutate", "simulated update failure"},
+				expectedUpdateCalls: 1,
+			}),
+
+			Entry("should fail when mutation function fails", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", nil),
+					func(client.Object) error { return fmt.Errorf("mutation error") },
+				},
+				expectedErrs: []string{"failed to mutate", "mutation error"},
+			}),
+
+			Entry("should fail when mutation function expects wrong type", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", nil),
+					func(*corev1.Secret) {},
+				},
+				expectedErrs: []string{"failed to mutate", "mutation function expects *v1.Secret but got *v1.ConfigMap"},
+			}),
+		)
+	})
+
+	Describe("Delete", func() {
+		type testCase struct {
+			client                    *MockClient
+			globalArgs                []interface{}
+			existingObjects           []client.Object
+			methodArgs                []interface{}
+			expectedErrs              []string
+			expectedDeleted           []client.Object
+			expectedRemaining         []client.Object
+			expectedPropagationPolicy *metav1.DeletionPropagation
+			expectedGracePeriod       *int64
+		}
+		DescribeTable("deleting test resources",
+			func(tc testCase) {
+				// Create Sawchain
+				t, sc := newMockSawchain(tc.client, append([]interface{}{fastTimeout, fastInterval}, tc.globalArgs...)...)
+
+				// Create existing resources
+				for _, obj := range tc.existingObjects {
+					Expect(tc.client.Client.Create(ctx, obj)).To(Succeed())
+				}
+
+				// Test Delete
+				runOp(func() { sc.Delete(ctx, tc.methodArgs...) })
+
+				if len(tc.expectedErrs) > 0 {
+					// Verify failure
+					Expect(t.Failed()).To(BeTrue(), "expected Delete to fail")
+					for _, expectedErr := range tc.expectedErrs {
+						Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedErr)))
+					}
+					return
+				}
+				Expect(t.Failed()).To(BeFalse(), "expected Delete to succeed: %v", t.ErrorLogs)
+
+				// Verify delete options
+				if len(tc.expectedDeleted) > 0 {
+					Expect(tc.client.lastDeleteOptions.PropagationPolicy).To(Equal(tc.expectedPropagationPolicy))
+					Expect(tc.client.lastDeleteOptions.GracePeriodSeconds).To(Equal(tc.expectedGracePeriod))
+				}
+
+				// Verify resources are deleted
+				for _, obj := range tc.expectedDeleted {
+					err := tc.client.Get(ctx, client.ObjectKeyFromObject(obj), &corev1.ConfigMap{})
+					Expect(apierrors.IsNotFound(err)).To(BeTrue(), "expected resource to be deleted: %v", err)
+				}
+
+				// Verify other resources remain
+				for _, obj := range tc.expectedRemaining {
+					Expect(tc.client.Get(ctx, client.ObjectKeyFromObject(obj), &corev1.ConfigMap{})).To(Succeed())
+				}
+			},
+
+			Entry("should delete resource with object and delete options", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", nil),
+				},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", nil),
+					client.PropagationPolicy(metav1.DeletePropagationForeground),
+					client.GracePeriodSeconds(0),
+				},
+				expectedDeleted: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", nil),
+				},
+				expectedPropagationPolicy: ptr.To(metav1.DeletePropagationForeground),
+				expectedGracePeriod:       ptr.To(int64(0)),
+			}),
+
+			Entry("should delete resources with template", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm1", "default", nil),
+					testutil.NewConfigMap("test-cm2", "default", nil),
+				},
+				methodArgs: []interface{}{
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm1
+  namespace: default
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm2
+  namespace: default
+`,
+					client.PropagationPolicy(metav1.DeletePropagationBackground),
+				},
+				expectedDeleted: []client.Object{
+					testutil.NewConfigMap("test-cm1", "default", nil),
+					testutil.NewConfigMap("test-cm2", "default", nil),
+				},
+				expectedPropagationPolicy: ptr.To(metav1.DeletePropagationBackground),
+			}),
+
+			Entry("should delete all resources matching unnamed template", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					withLabels(testutil.NewConfigMap("test-cm1", "default", nil), map[string]string{"app": "foo"}),
+					withLabels(testutil.NewConfigMap("test-cm2", "default", nil), map[string]string{"app": "foo"}),
+					withLabels(testutil.NewConfigMap("test-cm3", "default", nil), map[string]string{"app": "bar"}),
+					withLabels(testutil.NewConfigMap("test-cm4", "other", nil), map[string]string{"app": "foo"}),
+				},
+				methodArgs: []interface{}{
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  namespace: ($namespace)
+  labels:
+    app: foo
+`,
+					map[string]any{"namespace": "default"},
+				},
+				expectedDeleted: []client.Object{
+					testutil.NewConfigMap("test-cm1", "default", nil),
+					testutil.NewConfigMap("test-cm2", "default", nil),
+				},
+				expectedRemaining: []client.Object{
+					testutil.NewConfigMap("test-cm3", "default", nil),
+					testutil.NewConfigMap("test-cm4", "other", nil),
+				},
+			}),
+
+			Entry("should delete resources matching unnamed template that appear after listing", testCase{
+				client: &MockClient{
+					Client: testutil.NewStandardFakeClient(),
+					deleteRecreates: []client.Object{
+						withLabels(testutil.NewConfigMap("test-cm2", "default", nil), map[string]string{"app": "foo"}),
+					},
+				},
+				existingObjects: []client.Object{
+					withLabels(testutil.NewConfigMap("test-cm1", "default", nil), map[string]string{"app": "foo"}),
+				},
+				methodArgs: []interface{}{
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  namespace: default
+  labels:
+    app: foo
+`,
+				},
+				expectedDeleted: []client.Object{
+					testutil.NewConfigMap("test-cm1", "default", nil),
+					testutil.NewConfigMap("test-cm2", "default", nil),
+				},
+			}),
+
+			Entry("should succeed when unnamed template matches no resources", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					withLabels(testutil.NewConfigMap("test-cm", "default", nil), map[string]string{"app": "bar"}),
+				},
+				methodArgs: []interface{}{
+					`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  namespace: default
+  labels:
+    app: foo
+`,
+				},
+				expectedRemaining: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", nil),
+				},
+			}),
+
+			Entry("should remove finalizers from resource stuck in deletion", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					withFinalizers(testutil.NewConfigMap("test-cm", "default", nil), "example.com/finalizer"),
+				},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", nil),
+					sawchain.RemoveFinalizers,
+				},
+				expectedDeleted: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", nil),
+				},
+			}),
+
+			Entry("should remove finalizers with global setting", testCase{
+				client:     &MockClient{Client: testutil.NewStandardFakeClient()},
+				globalArgs: []interface{}{sawchain.RemoveFinalizers},
+				existingObjects: []client.Object{
+					withFinalizers(testutil.NewConfigMap("test-cm", "default", nil), "example.com/finalizer"),
+				},
+				methodArgs: []interface{}{
+					testutil.NewUnstructuredConfigMap("test-cm", "default", nil),
+				},
+				expectedDeleted: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", nil),
+				},
+			}),
+
+			Entry("should list finalizers blocking deletion", testCase{
+				client: &MockClient{Client: testutil.NewStandardFakeClient()},
+				existingObjects: []client.Object{
+					withFinalizers(testutil.NewConfigMap("test-cm", "default", nil), "example.com/a", "example.com/b"),
+				},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", nil),
+				},
+				expectedErrs: []string{
+					"client cache not synced within timeout",
+					"deletion is blocked by finalizers: example.com/a, example.com/b",
+				},
+			}),
+
+			Entry("should fail when delete fails", testCase{
+				client: &MockClient{
+					Client:           testutil.NewStandardFakeClient(),
+					deleteFailFirstN: 1,
+				},
+				existingObjects: []client.Object{
+					testutil.NewConfigMap("test-cm", "default", nil),
+				},
+				methodArgs: []interface{}{
+					testutil.NewConfigMap("test-cm", "default", nil),
+				},
+				expectedErrs: []string{"failed to delete with object", "simulated delete failure"},
+			}),
+		)
+	})
+
+	Describe("Subresources", func() {
+		var (
+			t  *MockT
+			c  client.Client
+			sc *sawchain.Sawchain
+		)
+
+		newDeployment := func(replicas int32) *appsv1.Deployment {
+			return &appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: "default"},
+				Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			}
+		}
+
+		BeforeEach(func() {
+			c = &MockClient{Client: fake.NewClientBuilder().
+				WithScheme(testutil.NewStandardScheme()).
+				WithStatusSubresource(&appsv1.Deployment{}).
+				Build()}
+			t, sc = newMockSawchain(c, fastTimeout, fastInterval)
+			Expect(c.Create(ctx, withLabels(newDeployment(1), map[string]string{"app": "test"}))).To(Succeed())
+		})
+
+		It("should update status with a template and save to object", func() {
+			deployment := &appsv1.Deployment{}
+			runOp(func() {
+				sc.Update(ctx, deployment, sawchain.StatusSubresource, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+  namespace: default
+status:
+  readyReplicas: 1
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Update to succeed: %v", t.ErrorLogs)
+			Expect(deployment.Status.ReadyReplicas).To(Equal(int32(1)))
+
+			actual := &appsv1.Deployment{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), actual)).To(Succeed())
+			Expect(actual.Status.ReadyReplicas).To(Equal(int32(1)))
+		})
+
+		It("should not update status without the status subresource", func() {
+			deployment := &appsv1.Deployment{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(newDeployment(1)), deployment)).To(Succeed())
+			deployment.Status.ReadyReplicas = 1
+			runOp(func() { sc.Update(ctx, deployment) })
+			Expect(t.Failed()).To(BeFalse(), "expected Update to succeed: %v", t.ErrorLogs)
+
+			actual := &appsv1.Deployment{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), actual)).To(Succeed())
+			Expect(actual.Status.ReadyReplicas).To(BeZero())
+		})
+
+		It("should patch status with a template", func() {
+			runOp(func() {
+				sc.Patch(ctx, sawchain.StatusSubresource, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+  namespace: default
+status:
+  availableReplicas: 1
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Patch to succeed: %v", t.ErrorLogs)
+
+			actual := &appsv1.Deployment{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(newDeployment(1)), actual)).To(Succeed())
+			Expect(actual.Status.AvailableReplicas).To(Equal(int32(1)))
+		})
+
+		It("should patch scale with a template and save the resulting state to object", func() {
+			deployment := &appsv1.Deployment{}
+			runOp(func() {
+				sc.Patch(ctx, deployment, sawchain.ScaleSubresource, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+  namespace: default
+spec:
+  replicas: 3
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Patch to succeed: %v", t.ErrorLogs)
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(3)))
+			Expect(deployment.Labels).To(Equal(map[string]string{"app": "test"}))
+
+			actual := &appsv1.Deployment{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), actual)).To(Succeed())
+			Expect(*actual.Spec.Replicas).To(Equal(int32(3)))
+			Expect(deployment.ResourceVersion).To(Equal(actual.ResourceVersion))
+		})
+
+		It("should save the resulting state to object after a dry run scale patch", func() {
+			deployment := &appsv1.Deployment{}
+			runOp(func() {
+				sc.Patch(ctx, deployment, sawchain.ScaleSubresource, client.DryRunAll, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+  namespace: default
+spec:
+  replicas: 3
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Patch to succeed: %v", t.ErrorLogs)
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(3)))
+			Expect(deployment.Labels).To(Equal(map[string]string{"app": "test"}))
+
+			actual := &appsv1.Deployment{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), actual)).To(Succeed())
+			Expect(*actual.Spec.Replicas).To(Equal(int32(1)))
+		})
+
+		It("should fail to patch scale without replicas", func() {
+			runOp(func() {
+				sc.Patch(ctx, sawchain.ScaleSubresource, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+  namespace: default
+`)
+			})
+			Expect(t.Failed()).To(BeTrue(), "expected Patch to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(
+				"spec.replicas is required to update the scale subresource")))
+		})
+
+		It("should update scale with an unstructured object", func() {
+			obj, err := util.UnstructuredFromObject(c, newDeployment(3))
+			Expect(err).NotTo(HaveOccurred())
+			runOp(func() { sc.Update(ctx, &obj, sawchain.ScaleSubresource) })
+			Expect(t.Failed()).To(BeFalse(), "expected Update to succeed: %v", t.ErrorLogs)
+
+			actual := &appsv1.Deployment{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(&obj), actual)).To(Succeed())
+			Expect(*actual.Spec.Replicas).To(Equal(int32(3)))
+		})
+
+		It("should fail to update scale without replicas", func() {
+			runOp(func() {
+				sc.Update(ctx, sawchain.ScaleSubresource, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+  namespace: default
+`)
+			})
+			Expect(t.Failed()).To(BeTrue(), "expected Update to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(
+				"spec.replicas is required to update the scale subresource")))
+		})
+
+		It("should fetch scale with an object", func() {
+			var result client.Object
+			runOp(func() { result = sc.FetchSingle(ctx, newDeployment(0), sawchain.ScaleSubresource) })
+			Expect(t.Failed()).To(BeFalse(), "expected FetchSingle to succeed: %v", t.ErrorLogs)
+			Expect(result).To(BeAssignableToTypeOf(&autoscalingv1.Scale{}))
+			Expect(result.(*autoscalingv1.Scale).Spec.Replicas).To(Equal(int32(1)))
+		})
+
+		It("should get scale with a template and save to object", func() {
+			scale := &autoscalingv1.Scale{}
+			var err error
+			runOp(func() {
+				err = sc.Get(ctx, scale, sawchain.ScaleSubresource, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+  namespace: default
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Get to succeed: %v", t.ErrorLogs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scale.Name).To(Equal("test-deployment"))
+			Expect(scale.Spec.Replicas).To(Equal(int32(1)))
+		})
+
+		It("should fail to get scale with a template and save to a typed resource", func() {
+			deployment := &appsv1.Deployment{}
+			runOp(func() {
+				_ = sc.Get(ctx, deployment, sawchain.ScaleSubresource, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+  namespace: default
+`)
+			})
+			Expect(t.Failed()).To(BeTrue(), "expected Get to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(
+				"scale subresource reads only save to *autoscalingv1.Scale objects")))
+			Expect(deployment.Name).To(BeEmpty())
+		})
+
+		It("should fail to get scale with a typed resource and no template", func() {
+			deployment := newDeployment(0)
+			runOp(func() { _ = sc.Get(ctx, deployment, sawchain.ScaleSubresource) })
+			Expect(t.Failed()).To(BeTrue(), "expected Get to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(
+				"Get requires a template to read the scale subresource")))
+			Expect(*deployment.Spec.Replicas).To(BeZero())
+		})
+
+		It("should fail to fetch scale with a template and save to a typed resource", func() {
+			runOp(func() {
+				sc.FetchSingle(ctx, &appsv1.Deployment{}, sawchain.ScaleSubresource, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+  namespace: default
+`)
+			})
+			Expect(t.Failed()).To(BeTrue(), "expected FetchSingle to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(
+				"scale subresource reads only save to *autoscalingv1.Scale objects")))
+		})
+	})
+
+	Describe("Reconciliation", func() {
+		var (
+			t  *MockT
+			c  client.Client
+			sc *sawchain.Sawchain
+		)
+
+		newDeployment := func(generation, observedGeneration int64) *appsv1.Deployment {
+			return &appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: "default", Generation: generation},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: observedGeneration},
+			}
+		}
+
+		// reconcileAfter simulates a controller observing the current generation after a delay.
+		reconcileAfter := func(delay time.Duration) {
+			go func() {
+				defer GinkgoRecover()
+				time.Sleep(delay)
+				Eventually(func() error {
+					deployment := &appsv1.Deployment{}
+					if err := c.Get(ctx, client.ObjectKeyFromObject(newDeployment(0, 0)), deployment); err != nil {
+						return err
+					}
+					deployment.Status.ObservedGeneration = deployment.Generation
+					return c.Status().Update(ctx, deployment)
+				}).Should(Succeed())
+			}()
+		}
+
+		BeforeEach(func() {
+			t = &MockT{TB: GinkgoTB()}
+			c = testutil.NewStandardFakeClient()
+		})
+
+		It("should wait for reconciliation after create when enabled globally", func() {
+			sc = sawchain.New(t, c, "1s", fastInterval, sawchain.WaitForReconciliation, sawchain.SkipCleanup)
+			deployment := newDeployment(1, 0)
+			reconcileAfter(3 * fastInterval)
+			runOp(func() { sc.Create(ctx, deployment) })
+			Expect(t.Failed()).To(BeFalse(), "expected Create to succeed: %v", t.ErrorLogs)
+			Expect(deployment.Status.ObservedGeneration).To(Equal(int64(1)))
+		})
+
+		It("should wait for reconciliation after update when enabled per call", func() {
+			sc = sawchain.New(t, c, "1s", fastInterval)
+			Expect(c.Create(ctx, newDeployment(1, 1))).To(Succeed())
+			deployment := &appsv1.Deployment{}
+			reconcileAfter(3 * fastInterval)
+			runOp(func() {
+				sc.Update(ctx, deployment, sawchain.WaitForReconciliation, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+  namespace: default
+  generation: 2
+status:
+  observedGeneration: 1
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Update to succeed: %v", t.ErrorLogs)
+			Expect(deployment.Status.ObservedGeneration).To(Equal(int64(2)))
+		})
+
+		It("should not wait for reconciliation when disabled", func() {
+			sc = sawchain.New(t, c, fastTimeout, fastInterval, sawchain.SkipCleanup)
+			deployment := newDeployment(1, 0)
+			runOp(func() { sc.Create(ctx, deployment) })
+			Expect(t.Failed()).To(BeFalse(), "expected Create to succeed: %v", t.ErrorLogs)
+			Expect(deployment.Status.ObservedGeneration).To(BeZero())
+		})
+
+		It("should not check resources without a generation", func() {
+			sc = sawchain.New(t, c, fastTimeout, fastInterval, sawchain.WaitForReconciliation, sawchain.SkipCleanup)
+			runOp(func() { sc.Create(ctx, testutil.NewConfigMap("test-cm", "default", nil)) })
+			Expect(t.Failed()).To(BeFalse(), "expected Create to succeed: %v", t.ErrorLogs)
+		})
+
+		It("should fail when the resource is not reconciled within the timeout", func() {
+			sc = sawchain.New(t, c, fastTimeout, fastInterval, sawchain.SkipCleanup)
+			runOp(func() { sc.Create(ctx, newDeployment(1, 0), sawchain.WaitForReconciliation) })
+			Expect(t.Failed()).To(BeTrue(), "expected Create to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("resource not reconciled within timeout")))
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(
+				"observed generation not found at status.observedGeneration")))
+		})
+
+		It("should read the observed generation from a custom path", func() {
+			sc = sawchain.New(t, c, fastTimeout, fastInterval, sawchain.SkipCleanup)
+			runOp(func() {
+				sc.Create(ctx, newDeployment(1, 1), sawchain.WaitForReconciliationAt("status.custom.observedGeneration"))
+			})
+			Expect(t.Failed()).To(BeTrue(), "expected Create to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(
+				"observed generation not found at status.custom.observedGeneration")))
+		})
+	})
+
+	Describe("Generated Names", func() {
+		var (
+			t  *MockT
+			c  client.Client
+			sc *sawchain.Sawchain
+		)
+
+		BeforeEach(func() {
+			c = testutil.NewStandardFakeClient()
+			t, sc = newMockSawchain(c, fastTimeout, fastInterval, sawchain.SkipCleanup)
+		})
+
+		It("should create with a template and save the generated name to object", func() {
+			configMap := &corev1.ConfigMap{}
+			runOp(func() {
+				sc.Create(ctx, configMap, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  generateName: test-cm-
+  namespace: default
+data:
+  key: value
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Create to succeed: %v", t.ErrorLogs)
+			Expect(configMap.Name).To(HavePrefix("test-cm-"))
+			Expect(configMap.Name).NotTo(Equal("test-cm-"))
+
+			actual := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(configMap), actual)).To(Succeed())
+			Expect(actual.Data).To(Equal(map[string]string{"key": "value"}))
+		})
+
+		It("should bind generated names for later template operations", func() {
+			runOp(func() {
+				sc.Create(ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  generateName: first-
+  namespace: default
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  generateName: second-
+  namespace: default
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Create to succeed: %v", t.ErrorLogs)
+
+			configMaps := []client.Object{&corev1.ConfigMap{}, &corev1.ConfigMap{}}
+			var err error
+			runOp(func() {
+				err = sc.Get(ctx, configMaps, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ($generatedNames."first-")
+  namespace: default
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ($generatedNames."second-")
+  namespace: default
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Get to succeed: %v", t.ErrorLogs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configMaps[0].GetName()).To(HavePrefix("first-"))
+			Expect(configMaps[1].GetName()).To(HavePrefix("second-"))
+		})
+
+		It("should create with a typed object and bind the most recent generated name", func() {
+			newConfigMap := func() *corev1.ConfigMap {
+				return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{GenerateName: "test-cm-", Namespace: "default"}}
+			}
+			first, second := newConfigMap(), newConfigMap()
+			runOp(func() { sc.Create(ctx, first) })
+			runOp(func() { sc.Create(ctx, second) })
+			Expect(t.Failed()).To(BeFalse(), "expected Create to succeed: %v", t.ErrorLogs)
+			Expect(first.Name).To(HavePrefix("test-cm-"))
+			Expect(second.Name).To(HavePrefix("test-cm-"))
+			Expect(second.Name).NotTo(Equal(first.Name))
+
+			rendered := &corev1.ConfigMap{}
+			runOp(func() {
+				sc.RenderToObject(rendered, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ($generatedNames."test-cm-")
+  namespace: default
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected RenderToObject to succeed: %v", t.ErrorLogs)
+			Expect(rendered.Name).To(Equal(second.Name))
+		})
+	})
+
+	Describe("Dry Run", func() {
+		var (
+			t  *MockT
+			c  client.Client
+			sc *sawchain.Sawchain
+		)
+
+		expectData := func(data map[string]string) {
+			actual := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "test-cm", Namespace: "default"}, actual)).To(Succeed())
+			Expect(actual.Data).To(Equal(data))
+		}
+
+		BeforeEach(func() {
+			c = testutil.NewStandardFakeClient()
+			t, sc = newMockSawchain(c, fastTimeout, fastInterval)
+		})
+
+		It("should not persist or clean up resources created with dry run", func() {
+			configMap := &corev1.ConfigMap{}
+			runOp(func() {
+				sc.Create(ctx, configMap, client.DryRunAll, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+data:
+  key: value
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Create to succeed: %v", t.ErrorLogs)
+			Expect(configMap.Name).To(Equal("test-cm"))
+			Expect(configMap.Data).To(Equal(map[string]string{"key": "value"}))
+			Expect(t.cleanups).To(BeEmpty())
+
+			err := c.Get(ctx, client.ObjectKeyFromObject(configMap), &corev1.ConfigMap{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue(), "expected resource not to be created")
+		})
+
+		It("should not persist resources updated with dry run", func() {
+			Expect(c.Create(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}))).To(Succeed())
+			runOp(func() {
+				sc.Update(ctx, client.DryRunAll, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+data:
+  key: updated-value
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Update to succeed: %v", t.ErrorLogs)
+			expectData(map[string]string{"key": "value"})
+		})
+
+		It("should detect dry run set in options structs", func() {
+			runOp(func() {
+				sc.Create(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+					&client.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Create to succeed: %v", t.ErrorLogs)
+			Expect(t.cleanups).To(BeEmpty())
+			err := c.Get(ctx, types.NamespacedName{Name: "test-cm", Namespace: "default"}, &corev1.ConfigMap{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue(), "expected resource not to be created")
+
+			Expect(c.Create(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}))).To(Succeed())
+			runOp(func() {
+				sc.Update(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "updated-value"}),
+					&client.UpdateOptions{DryRun: []string{metav1.DryRunAll}})
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Update to succeed: %v", t.ErrorLogs)
+			expectData(map[string]string{"key": "value"})
+		})
+
+		It("should not persist resources patched with dry run", func() {
+			Expect(c.Create(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}))).To(Succeed())
+			runOp(func() {
+				sc.Patch(ctx, client.DryRunAll, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+data:
+  key: patched-value
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Patch to succeed: %v", t.ErrorLogs)
+			expectData(map[string]string{"key": "value"})
+		})
+
+		It("should not wait for deletion of resources deleted with dry run", func() {
+			configMap := testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"})
+			Expect(c.Create(ctx, configMap)).To(Succeed())
+			runOp(func() { sc.Delete(ctx, configMap, client.DryRunAll) })
+			Expect(t.Failed()).To(BeFalse(), "expected Delete to succeed: %v", t.ErrorLogs)
+			expectData(map[string]string{"key": "value"})
+		})
+	})
+
+	Describe("Expect Error", func() {
+		var (
+			t  *MockT
+			c  *MockClient
+			sc *sawchain.Sawchain
+		)
+
+		BeforeEach(func() {
+			c = &MockClient{Client: testutil.NewStandardFakeClient()}
+			t, sc = newMockSawchain(c, fastTimeout, fastInterval)
+		})
+
+		It("should return create rejection with status details", func() {
+			c.createRejection = apierrors.NewInvalid(schema.GroupKind{Kind: "ConfigMap"}, "test-cm", field.ErrorList{
+				field.Invalid(field.NewPath("data", "key"), "value", "must not be value"),
+			})
+			var err error
+			runOp(func() {
+				err = sc.CreateExpectError(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}))
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected CreateExpectError to succeed: %v", t.ErrorLogs)
+			Expect(err).To(sc.HaveStatusReason(metav1.StatusReasonInvalid))
+			Expect(err).To(sc.HaveStatusCode(http.StatusUnprocessableEntity))
+			Expect(err).To(sc.HaveStatusCause(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   "data.key",
+				Message: "must not be value",
+			}))
+			Expect(t.cleanups).To(BeEmpty())
+		})
+
+		It("should clean up resources created before the rejection", func() {
+			Expect(c.Client.Create(ctx, testutil.NewConfigMap("second", "default", nil))).To(Succeed())
+			configMaps := []client.Object{&corev1.ConfigMap{}, &corev1.ConfigMap{}}
+			var err error
+			runOp(func() {
+				err = sc.CreateExpectError(ctx, configMaps, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: first
+  namespace: default
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: second
+  namespace: default
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected CreateExpectError to succeed: %v", t.ErrorLogs)
+			Expect(err).To(sc.HaveStatusReason(metav1.StatusReasonAlreadyExists))
+			Expect(configMaps[1].GetName()).To(Equal("second"), "expected template state to be written to objects")
+			Expect(t.cleanups).To(HaveLen(1))
+
+			runOp(t.RunCleanups)
+			Expect(t.Failed()).To(BeFalse(), "expected cleanup to succeed: %v", t.ErrorLogs)
+			err = c.Get(ctx, types.NamespacedName{Name: "first", Namespace: "default"}, &corev1.ConfigMap{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue(), "expected created resource to be cleaned up")
+		})
+
+		It("should fail when create is not rejected", func() {
+			runOp(func() { sc.CreateExpectError(ctx, testutil.NewConfigMap("test-cm", "default", nil)) })
+			Expect(t.Failed()).To(BeTrue(), "expected CreateExpectError to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(
+				"expected create to be rejected, but all resources were created")))
+		})
+
+		It("should return update rejection with status details", func() {
+			configMap := testutil.NewConfigMap("test-cm", "default", nil)
+			Expect(c.Client.Create(ctx, configMap)).To(Succeed())
+			Expect(c.Client.Update(ctx, configMap.DeepCopy())).To(Succeed())
+			var err error
+			runOp(func() { err = sc.UpdateExpectError(ctx, configMap) })
+			Expect(t.Failed()).To(BeFalse(), "expected UpdateExpectError to succeed: %v", t.ErrorLogs)
+			Expect(err).To(sc.HaveStatusReason(metav1.StatusReasonConflict))
+			Expect(err).To(sc.HaveStatusCode(http.StatusConflict))
+		})
+
+		It("should return update rejection with a template", func() {
+			var err error
+			runOp(func() {
+				err = sc.UpdateExpectError(ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected UpdateExpectError to succeed: %v", t.ErrorLogs)
+			Expect(err).To(sc.HaveStatusReason(metav1.StatusReasonNotFound))
+		})
+
+		It("should fail when update is not rejected", func() {
+			configMap := testutil.NewConfigMap("test-cm", "default", nil)
+			Expect(c.Client.Create(ctx, configMap)).To(Succeed())
+			runOp(func() { sc.UpdateExpectError(ctx, configMap) })
+			Expect(t.Failed()).To(BeTrue(), "expected UpdateExpectError to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(
+				"expected update to be rejected, but all resources were updated")))
+		})
+	})
+
+	Describe("Dependency Ordering", func() {
+		var (
+			t      *MockT
+			c      *MockClient
+			mapper *meta.DefaultRESTMapper
+			sc     *sawchain.Sawchain
+		)
+
+		// Documents are intentionally out of dependency order
+		template := `
+apiVersion: example.com/v1
+kind: TestResource
+metadata:
+  name: test-resource
+  namespace: test-ns
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: testresources.example.com
+spec:
+  group: example.com
+  names:
+    kind: TestResource
+    plural: testresources
+  scope: Namespaced
+  versions:
+  - name: v1
+    served: true
+    storage: true
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: test-ns
+`
+
+		BeforeEach(func() {
+			mapper = meta.NewDefaultRESTMapper(nil)
+			c = &MockClient{
+				Client: fake.NewClientBuilder().
+					WithScheme(testutil.NewStandardSchemeWithTestResource()).
+					WithRESTMapper(mapper).
+					Build(),
+			}
+			t, sc = newMockSawchain(c, fastTimeout, fastInterval, sawchain.SkipCleanup)
+		})
+
+		It("should create Namespaces and CRDs before other resources", func() {
+			c.crdMapper = mapper
+			runOp(func() { sc.Create(ctx, template) })
+			Expect(t.Failed()).To(BeFalse(), "expected Create to succeed: %v", t.ErrorLogs)
+			Expect(c.createdNames).To(Equal([]string{"test-ns", "testresources.example.com", "test-resource"}))
+		})
+
+		It("should save resources in template order", func() {
+			c.crdMapper = mapper
+			objs := []client.Object{&unstructured.Unstructured{}, &unstructured.Unstructured{}, &corev1.Namespace{}}
+			runOp(func() { sc.Create(ctx, objs, template) })
+			Expect(t.Failed()).To(BeFalse(), "expected Create to succeed: %v", t.ErrorLogs)
+			Expect(objs[0].GetName()).To(Equal("test-resource"))
+			Expect(objs[1].GetName()).To(Equal("testresources.example.com"))
+			Expect(objs[2].GetName()).To(Equal("test-ns"))
+		})
+
+		It("should fail without creating custom resources when CRDs are not established", func() {
+			runOp(func() { sc.Create(ctx, template) })
+			Expect(t.Failed()).To(BeTrue(), "expected Create to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("CRD not established within timeout")))
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(
+				"CustomResourceDefinition (testresources.example.com): not established")))
+			Expect(c.createdNames).To(Equal([]string{"test-ns", "testresources.example.com"}))
+		})
+
+		It("should delete resources in reverse dependency order", func() {
+			c.crdMapper = mapper
+			runOp(func() { sc.Create(ctx, template) })
+			Expect(t.Failed()).To(BeFalse(), "expected Create to succeed: %v", t.ErrorLogs)
+			runOp(func() { sc.Delete(ctx, template) })
+			Expect(t.Failed()).To(BeFalse(), "expected Delete to succeed: %v", t.ErrorLogs)
+			Expect(c.deletedNames).To(Equal([]string{"test-resource", "testresources.example.com", "test-ns"}))
+		})
+	})
+
+	Describe("Ephemeral Namespace", func() {
+		var (
+			t  *MockT
+			c  client.Client
+			sc *sawchain.Sawchain
+		)
+
+		BeforeEach(func() {
+			scheme := testutil.NewStandardScheme()
+			c = fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(scheme)).
+				Build()
+			t, sc = newMockSawchain(c, fastTimeout, fastInterval)
+		})
+
+		It("should create a uniquely named namespace once", func() {
+			var first, second string
+			runOp(func() {
+				first = sc.EphemeralNamespace(ctx)
+				second = sc.EphemeralNamespace(ctx)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected EphemeralNamespace to succeed: %v", t.ErrorLogs)
+			Expect(first).To(HavePrefix(sawchain.EphemeralNamespacePrefix))
+			Expect(second).To(Equal(first))
+
+			namespaces := &corev1.NamespaceList{}
+			Expect(c.List(ctx, namespaces)).To(Succeed())
+			Expect(namespaces.Items).To(HaveLen(1))
+			Expect(namespaces.Items[0].Name).To(Equal(first))
+		})
+
+		It("should bind the namespace for template operations", func() {
+			var namespace string
+			configMap := &corev1.ConfigMap{}
+			runOp(func() {
+				namespace = sc.EphemeralNamespace(ctx)
+				sc.RenderToObject(configMap, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: ($namespace)
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected RenderToObject to succeed: %v", t.ErrorLogs)
+			Expect(configMap.Namespace).To(Equal(namespace))
+		})
+
+		It("should default the namespace onto namespaced resources that omit it", func() {
+			var namespace string
+			configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "object-cm"}}
+			explicit := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "explicit-cm", Namespace: "default"}}
+			clusterScoped := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-ns"}}
+			runOp(func() {
+				namespace = sc.EphemeralNamespace(ctx)
+				sc.Create(ctx, []client.Object{configMap, explicit, clusterScoped})
+				sc.Create(ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: template-cm
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Create to succeed: %v", t.ErrorLogs)
+			Expect(configMap.Namespace).To(Equal(namespace))
+			Expect(explicit.Namespace).To(Equal("default"))
+			Expect(clusterScoped.Namespace).To(BeEmpty())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "template-cm", Namespace: namespace}, &corev1.ConfigMap{})).To(Succeed())
+
+			fetched := &corev1.ConfigMap{}
+			runOp(func() {
+				sc.Get(ctx, fetched, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: template-cm
+`)
+				sc.Delete(ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: template-cm
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Get and Delete to succeed: %v", t.ErrorLogs)
+			Expect(fetched.Namespace).To(Equal(namespace))
+			err := c.Get(ctx, client.ObjectKey{Name: "template-cm", Namespace: namespace}, &corev1.ConfigMap{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue(), "expected resource to be deleted: %v", err)
+		})
+
+		It("should delete the namespace when the test ends", func() {
+			var namespace string
+			runOp(func() { namespace = sc.EphemeralNamespace(ctx) })
+			Expect(t.Failed()).To(BeFalse(), "expected EphemeralNamespace to succeed: %v", t.ErrorLogs)
+			Expect(c.Get(ctx, client.ObjectKey{Name: namespace}, &corev1.Namespace{})).To(Succeed())
+
+			runOp(t.RunCleanups)
+			Expect(t.Failed()).To(BeFalse(), "expected cleanup to succeed: %v", t.ErrorLogs)
+			err := c.Get(ctx, client.ObjectKey{Name: namespace}, &corev1.Namespace{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue(), "expected namespace to be deleted: %v", err)
+		})
+
+		It("should not delete the namespace with SkipCleanup", func() {
+			sc = sawchain.New(t, c, fastTimeout, fastInterval, sawchain.SkipCleanup)
+			var namespace string
+			runOp(func() { namespace = sc.EphemeralNamespace(ctx) })
+			Expect(t.Failed()).To(BeFalse(), "expected EphemeralNamespace to succeed: %v", t.ErrorLogs)
+
+			runOp(t.RunCleanups)
+			Expect(c.Get(ctx, client.ObjectKey{Name: namespace}, &corev1.Namespace{})).To(Succeed())
+		})
+	})
+
+	Describe("Concurrency", func() {
+		var (
+			t  *MockT
+			c  client.Client
+			sc *sawchain.Sawchain
+		)
+
+		// configMaps returns a multi-document template for n ConfigMaps named test-cm-<i>.
+		configMaps := func(n int) string {
+			documents := make([]string, n)
+			for i := range documents {
+				documents[i] = fmt.Sprintf(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm-%d
+  namespace: default
+data:
+  key: value
+`, i)
+			}
+			return strings.Join(documents, "---\n")
+		}
+
+		BeforeEach(func() {
+			c = testutil.NewStandardFakeClient()
+			t, sc = newMockSawchain(c, fastTimeout, fastInterval, sawchain.Concurrency(8))
+		})
+
+		It("should create, update, and delete many resources", func() {
+			runOp(func() { sc.Create(ctx, configMaps(50)) })
+			Expect(t.Failed()).To(BeFalse(), "expected Create to succeed: %v", t.ErrorLogs)
+			Expect(t.cleanups).To(HaveLen(50))
+			configMapList := &corev1.ConfigMapList{}
+			Expect(c.List(ctx, configMapList)).To(Succeed())
+			Expect(configMapList.Items).To(HaveLen(50))
+
+			runOp(func() { sc.Update(ctx, strings.ReplaceAll(configMaps(50), "value", "updated")) })
+			Expect(t.Failed()).To(BeFalse(), "expected Update to succeed: %v", t.ErrorLogs)
+			Expect(c.List(ctx, configMapList)).To(Succeed())
+			for _, configMap := range configMapList.Items {
+				Expect(configMap.Data).To(Equal(map[string]string{"key": "updated"}))
+			}
+
+			runOp(func() { sc.Delete(ctx, configMaps(50), sawchain.Concurrency(4)) })
+			Expect(t.Failed()).To(BeFalse(), "expected Delete to succeed: %v", t.ErrorLogs)
+			Expect(c.List(ctx, configMapList)).To(Succeed())
+			Expect(configMapList.Items).To(BeEmpty())
+		})
+
+		It("should report all create errors and clean up created resources", func() {
+			Expect(c.Create(ctx, testutil.NewConfigMap("test-cm-1", "default", nil))).To(Succeed())
+			Expect(c.Create(ctx, testutil.NewConfigMap("test-cm-3", "default", nil))).To(Succeed())
+
+			runOp(func() { sc.Create(ctx, configMaps(5)) })
+			Expect(t.Failed()).To(BeTrue(), "expected Create to fail")
+			Expect(t.ErrorLogs).To(ContainElement(And(
+				ContainSubstring("failed to create with template"),
+				ContainSubstring(`"test-cm-1" already exists`),
+				ContainSubstring(`"test-cm-3" already exists`),
+			)))
+			Expect(t.cleanups).To(HaveLen(3))
+			for _, name := range []string{"test-cm-0", "test-cm-2", "test-cm-4"} {
+				Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, &corev1.ConfigMap{})).To(Succeed())
+			}
+		})
+
+		It("should report all update errors", func() {
+			Expect(c.Create(ctx, testutil.NewConfigMap("test-cm-0", "default", nil))).To(Succeed())
+
+			runOp(func() { sc.Update(ctx, configMaps(3)) })
+			Expect(t.Failed()).To(BeTrue(), "expected Update to fail")
+			Expect(t.ErrorLogs).To(ContainElement(And(
+				ContainSubstring("failed to update with template"),
+				ContainSubstring(`"test-cm-1" not found`),
+				ContainSubstring(`"test-cm-2" not found`),
+			)))
+		})
+
+		It("should stop at the first error without concurrency", func() {
+			sc = sawchain.New(t, c, fastTimeout, fastInterval)
+			Expect(c.Create(ctx, testutil.NewConfigMap("test-cm-1", "default", nil))).To(Succeed())
+
+			runOp(func() { sc.Create(ctx, configMaps(3)) })
+			Expect(t.Failed()).To(BeTrue(), "expected Create to fail")
+			Expect(t.cleanups).To(HaveLen(1))
+			err := c.Get(ctx, client.ObjectKey{Name: "test-cm-2", Namespace: "default"}, &corev1.ConfigMap{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue(), "expected resource not to be created: %v", err)
+		})
+	})
+
+	Describe("Merge Onto Live", func() {
+		var (
+			t  *MockT
+			c  client.Client
+			sc *sawchain.Sawchain
+		)
+
+		partialTemplate := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+data:
+  key2: updated
+`
+
+		BeforeEach(func() {
+			c = testutil.NewStandardFakeClient()
+			t, sc = newMockSawchain(c, fastTimeout, fastInterval)
+			configMap := testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "value1", "key2": "value2"})
+			configMap.Labels = map[string]string{"app": "test"}
+			Expect(c.Create(ctx, configMap)).To(Succeed())
+		})
+
+		It("should merge a partial template onto the live state", func() {
+			configMap := &corev1.ConfigMap{}
+			runOp(func() { sc.Update(ctx, configMap, partialTemplate, sawchain.MergeOntoLive) })
+			Expect(t.Failed()).To(BeFalse(), "expected Update to succeed: %v", t.ErrorLogs)
+			Expect(configMap.Data).To(Equal(map[string]string{"key1": "value1", "key2": "updated"}))
+			Expect(configMap.Labels).To(Equal(map[string]string{"app": "test"}))
+
+			actual := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(configMap), actual)).To(Succeed())
+			Expect(actual.Data).To(Equal(map[string]string{"key1": "value1", "key2": "updated"}))
+			Expect(actual.Labels).To(Equal(map[string]string{"app": "test"}))
+		})
+
+		It("should merge onto the live state when enabled globally", func() {
+			sc = sawchain.New(t, c, fastTimeout, fastInterval, sawchain.MergeOntoLive)
+			runOp(func() { sc.Update(ctx, partialTemplate) })
+			Expect(t.Failed()).To(BeFalse(), "expected Update to succeed: %v", t.ErrorLogs)
+
+			actual := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "test-cm", Namespace: "default"}, actual)).To(Succeed())
+			Expect(actual.Data).To(Equal(map[string]string{"key1": "value1", "key2": "updated"}))
+		})
+
+		It("should replace the resource without MergeOntoLive", func() {
+			runOp(func() { sc.Update(ctx, partialTemplate) })
+			Expect(t.Failed()).To(BeFalse(), "expected Update to succeed: %v", t.ErrorLogs)
+
+			actual := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "test-cm", Namespace: "default"}, actual)).To(Succeed())
+			Expect(actual.Data).To(Equal(map[string]string{"key2": "updated"}))
+			Expect(actual.Labels).To(BeEmpty())
+		})
+
+		It("should fail when the resource does not exist", func() {
+			runOp(func() {
+				sc.Update(ctx, strings.ReplaceAll(partialTemplate, "test-cm", "missing-cm"), sawchain.MergeOntoLive)
+			})
+			Expect(t.Failed()).To(BeTrue(), "expected Update to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("failed to get live state to merge template onto")))
+		})
+	})
+
+	Describe("List", func() {
+		var (
+			t  *MockT
+			c  client.Client
+			sc *sawchain.Sawchain
+		)
+
+		// names returns the names of the ConfigMaps in the list.
+		names := func(list *corev1.ConfigMapList) []string {
+			var result []string
+			for _, item := range list.Items {
+				result = append(result, item.Name)
+			}
+			return result
+		}
+
+		labeledTemplate := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  namespace: default
+  labels:
+    app: test
+`
+
+		BeforeEach(func() {
+			c = fake.NewClientBuilder().
+				WithScheme(testutil.NewStandardScheme()).
+				WithIndex(&corev1.ConfigMap{}, "metadata.name", func(obj client.Object) []string {
+					return []string{obj.GetName()}
+				}).
+				Build()
+			t, sc = newMockSawchain(c, fastTimeout, fastInterval)
+			for _, configMap := range []*corev1.ConfigMap{
+				testutil.NewConfigMap("match-1", "default", nil),
+				testutil.NewConfigMap("match-2", "default", nil),
+				testutil.NewConfigMap("other-labels", "default", nil),
+				testutil.NewConfigMap("other-namespace", "other", nil),
+			} {
+				if configMap.Name != "other-labels" {
+					configMap.Labels = map[string]string{"app": "test"}
+				}
+				Expect(c.Create(ctx, configMap)).To(Succeed())
+			}
+		})
+
+		It("should list resources matching a template into a typed list", func() {
+			var list client.ObjectList
+			runOp(func() { list = sc.FetchList(ctx, labeledTemplate) })
+			Expect(t.Failed()).To(BeFalse(), "expected FetchList to succeed: %v", t.ErrorLogs)
+			Expect(list).To(BeAssignableToTypeOf(&corev1.ConfigMapList{}))
+			Expect(names(list.(*corev1.ConfigMapList))).To(ConsistOf("match-1", "match-2"))
+		})
+
+		It("should list resources of a typed list's type with list options", func() {
+			list := &corev1.ConfigMapList{}
+			runOp(func() { sc.FetchList(ctx, list, client.MatchingLabels{"app": "test"}) })
+			Expect(t.Failed()).To(BeFalse(), "expected FetchList to succeed: %v", t.ErrorLogs)
+			Expect(names(list)).To(ConsistOf("match-1", "match-2", "other-namespace"))
+		})
+
+		It("should list resources matching a template and field selector", func() {
+			list := &corev1.ConfigMapList{}
+			runOp(func() { sc.FetchList(ctx, list, labeledTemplate, client.MatchingFields{"metadata.name": "match-2"}) })
+			Expect(t.Failed()).To(BeFalse(), "expected FetchList to succeed: %v", t.ErrorLogs)
+			Expect(names(list)).To(ConsistOf("match-2"))
+		})
+
+		It("should list resources matching a template into an empty unstructured list", func() {
+			list := &unstructured.UnstructuredList{}
+			runOp(func() { sc.FetchList(ctx, list, labeledTemplate) })
+			Expect(t.Failed()).To(BeFalse(), "expected FetchList to succeed: %v", t.ErrorLogs)
+			Expect(list.GetKind()).To(Equal("ConfigMapList"))
+			Expect(list.Items).To(HaveLen(2))
+		})
+
+		It("should fail with a named template", func() {
+			runOp(func() {
+				sc.FetchList(ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: match-1
+  namespace: default
+`)
+			})
+			Expect(t.Failed()).To(BeTrue(), "expected FetchList to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("list template must not contain a resource name")))
+		})
+
+		It("should fail with a list of a different type than the template", func() {
+			runOp(func() { sc.FetchList(ctx, &corev1.SecretList{}, labeledTemplate) })
+			Expect(t.Failed()).To(BeTrue(), "expected FetchList to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("object list type must match template resource type")))
+		})
+
+		It("should return a function that lists resources on each call", func() {
+			var fetchList func() client.ObjectList
+			runOp(func() { fetchList = sc.FetchListFunc(ctx, labeledTemplate) })
+			Expect(t.Failed()).To(BeFalse(), "expected FetchListFunc to succeed: %v", t.ErrorLogs)
+			Expect(fetchList()).To(HaveField("Items", HaveLen(2)))
+
+			configMap := testutil.NewConfigMap("match-3", "default", nil)
+			configMap.Labels = map[string]string{"app": "test"}
+			Expect(c.Create(ctx, configMap)).To(Succeed())
+			Eventually(fetchList).Should(HaveField("Items", HaveLen(3)))
+		})
+	})
+
+	Describe("Generics", func() {
+		var (
+			t  *MockT
+			c  client.Client
+			sc *sawchain.Sawchain
+		)
+
+		template := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+`
+
+		BeforeEach(func() {
+			c = testutil.NewStandardFakeClient()
+			t, sc = newMockSawchain(c, fastTimeout, fastInterval)
+			Expect(c.Create(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}))).To(Succeed())
+		})
+
+		It("should fetch a typed resource with a template", func() {
+			var configMap *corev1.ConfigMap
+			runOp(func() { configMap = sawchain.Fetch[*corev1.ConfigMap](sc, ctx, template) })
+			Expect(t.Failed()).To(BeFalse(), "expected Fetch to succeed: %v", t.ErrorLogs)
+			Expect(configMap.Data).To(Equal(map[string]string{"key": "value"}))
+		})
+
+		It("should fetch a typed resource with an object", func() {
+			obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-cm", Namespace: "default"}}
+			var configMap *corev1.ConfigMap
+			runOp(func() { configMap = sawchain.Fetch[*corev1.ConfigMap](sc, ctx, obj) })
+			Expect(t.Failed()).To(BeFalse(), "expected Fetch to succeed: %v", t.ErrorLogs)
+			Expect(configMap).To(BeIdenticalTo(obj))
+			Expect(configMap.Data).To(Equal(map[string]string{"key": "value"}))
+		})
+
+		It("should fail instead of panicking when the template type doesn't match", func() {
+			runOp(func() { sawchain.Fetch[*corev1.Secret](sc, ctx, template) })
+			Expect(t.Failed()).To(BeTrue(), "expected Fetch to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("failed to save state to object")))
+		})
+
+		It("should fail instead of panicking when the object type doesn't match", func() {
+			obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-cm", Namespace: "default"}}
+			runOp(func() { sawchain.Fetch[*corev1.Secret](sc, ctx, obj) })
+			Expect(t.Failed()).To(BeTrue(), "expected Fetch to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(
+				"fetched object type doesn't match type parameter: expected *v1.Secret but got *v1.ConfigMap")))
+		})
+
+		It("should fail when the type parameter is not a pointer to a struct", func() {
+			runOp(func() { sawchain.Fetch[client.Object](sc, ctx, template) })
+			Expect(t.Failed()).To(BeTrue(), "expected Fetch to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("type parameter must be a pointer to a struct type")))
+		})
+
+		It("should fetch a typed list", func() {
+			var list *corev1.ConfigMapList
+			runOp(func() { list = sawchain.FetchList[*corev1.ConfigMapList](sc, ctx, client.InNamespace("default")) })
+			Expect(t.Failed()).To(BeFalse(), "expected FetchList to succeed: %v", t.ErrorLogs)
+			Expect(list.Items).To(HaveLen(1))
+			Expect(list.Items[0].Name).To(Equal("test-cm"))
+		})
+
+		It("should render a typed object", func() {
+			var configMap *corev1.ConfigMap
+			runOp(func() { configMap = sawchain.Render[*corev1.ConfigMap](sc, template) })
+			Expect(t.Failed()).To(BeFalse(), "expected Render to succeed: %v", t.ErrorLogs)
+			Expect(configMap.Name).To(Equal("test-cm"))
+			Expect(configMap.Namespace).To(Equal("default"))
+		})
+	})
+
+	Describe("Unstructured Fallback", func() {
+		var (
+			t  *MockT
+			c  client.Client
+			sc *sawchain.Sawchain
+		)
+
+		widgetTemplate := `
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: test-widget
+  namespace: default
+`
+
+		multiTemplate := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: test-widget
+  namespace: default
+`
+
+		BeforeEach(func() {
+			c = testutil.NewStandardFakeClient()
+			t, sc = newMockSawchain(c, fastTimeout, fastInterval)
+			widget := &unstructured.Unstructured{}
+			widget.SetAPIVersion("example.com/v1")
+			widget.SetKind("Widget")
+			widget.SetName("test-widget")
+			widget.SetNamespace("default")
+			Expect(unstructured.SetNestedField(widget.Object, "blue", "spec", "color")).To(Succeed())
+			Expect(c.Create(ctx, widget)).To(Succeed())
+			Expect(c.Create(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}))).To(Succeed())
+		})
+
+		expectWidget := func(obj client.Object) {
+			widget, ok := obj.(*unstructured.Unstructured)
+			Expect(ok).To(BeTrue(), "expected unstructured object but got %T", obj)
+			Expect(widget.GetName()).To(Equal("test-widget"))
+			Expect(widget.Object).To(HaveKeyWithValue("spec", map[string]interface{}{"color": "blue"}))
+		}
+
+		It("should return an unstructured object from FetchSingle for an unknown type", func() {
+			var fetched client.Object
+			runOp(func() { fetched = sc.FetchSingle(ctx, widgetTemplate) })
+			Expect(t.Failed()).To(BeFalse(), "expected FetchSingle to succeed: %v", t.ErrorLogs)
+			expectWidget(fetched)
+		})
+
+		It("should return an unstructured object from FetchSingleFunc for an unknown type", func() {
+			var fetched client.Object
+			runOp(func() { fetched = sc.FetchSingleFunc(ctx, widgetTemplate)() })
+			Expect(t.Failed()).To(BeFalse(), "expected FetchSingleFunc to succeed: %v", t.ErrorLogs)
+			expectWidget(fetched)
+		})
+
+		It("should return typed and unstructured objects from FetchMultiple", func() {
+			var fetched []client.Object
+			runOp(func() { fetched = sc.FetchMultiple(ctx, multiTemplate) })
+			Expect(t.Failed()).To(BeFalse(), "expected FetchMultiple to succeed: %v", t.ErrorLogs)
+			Expect(fetched).To(HaveLen(2))
+			Expect(fetched[0]).To(BeAssignableToTypeOf(&corev1.ConfigMap{}))
+			expectWidget(fetched[1])
+		})
+
+		It("should return typed and unstructured objects from FetchMultipleFunc", func() {
+			var fetched []client.Object
+			runOp(func() { fetched = sc.FetchMultipleFunc(ctx, multiTemplate)() })
+			Expect(t.Failed()).To(BeFalse(), "expected FetchMultipleFunc to succeed: %v", t.ErrorLogs)
+			Expect(fetched).To(HaveLen(2))
+			Expect(fetched[0]).To(BeAssignableToTypeOf(&corev1.ConfigMap{}))
+			expectWidget(fetched[1])
+		})
+	})
+
+	Describe("Multi-Document Check", func() {
+		var (
+			t  *MockT
+			sc *sawchain.Sawchain
+		)
+
+		BeforeEach(func() {
+			c := testutil.NewStandardFakeClient()
+			t, sc = newMockSawchain(c, fastTimeout, fastInterval)
+			for _, name := range []string{"cm-a", "cm-b", "cm-c"} {
+				Expect(c.Create(ctx, testutil.NewConfigMap(name, "default", map[string]string{
+					"script": "echo start\n---\necho end\n",
+				}))).To(Succeed())
+			}
+		})
+
+		It("should not split documents on separators within block scalars", func() {
+			var err error
+			runOp(func() {
+				err = sc.Check(ctx, `---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm-a
+  namespace: default
+data:
+  script: |
+    echo start
+    ---
+    echo end
+---
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Check not to fail: %v", t.ErrorLogs)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should report every failing document", func() {
+			template := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm-a
+  namespace: default
+data:
+  script: wrong
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm-b
+  namespace: default
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm-missing
+  namespace: default
+`
+			var err, funcErr error
+			runOp(func() {
+				err = sc.Check(ctx, template)
+				funcErr = sc.CheckFunc(ctx, template)()
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Check not to fail: %v", t.ErrorLogs)
+			for _, e := range []error{err, funcErr} {
+				Expect(e).To(HaveOccurred())
+				Expect(e.Error()).To(ContainSubstring("document 0: ConfigMap (default/cm-a): "))
+				Expect(e.Error()).To(ContainSubstring("data.script: Invalid value"))
+				Expect(e.Error()).NotTo(ContainSubstring("document 1"))
+				Expect(e.Error()).To(ContainSubstring("document 2: ConfigMap (default/cm-missing): actual resource not found"))
+			}
+		})
+
+		It("should save matches when all documents match", func() {
+			objs := []client.Object{&corev1.ConfigMap{}, &corev1.ConfigMap{}}
+			var err error
+			runOp(func() {
+				err = sc.Check(ctx, objs, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm-b
+  namespace: default
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm-c
+  namespace: default
+`)
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected Check not to fail: %v", t.ErrorLogs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objs[0].GetName()).To(Equal("cm-b"))
+			Expect(objs[1].GetName()).To(Equal("cm-c"))
+		})
+	})
+
+	Describe("Check All", func() {
+		var (
+			t  *MockT
+			sc *sawchain.Sawchain
+		)
+
+		template := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  namespace: default
+  labels:
+    app: web
+data:
+  state: ($state)
+`
+
+		BeforeEach(func() {
+			c := testutil.NewStandardFakeClient()
+			t, sc = newMockSawchain(c, fastTimeout, fastInterval)
+			for name, state := range map[string]string{"web-1": "running", "web-2": "running", "web-3": "pending"} {
+				cm := withLabels(testutil.NewConfigMap(name, "default", map[string]string{"state": state}),
+					map[string]string{"app": "web"})
+				Expect(c.Create(ctx, cm)).To(Succeed())
+			}
+		})
+
+		DescribeTable("checking match counts",
+			func(state string, count interface{}, expectedMatches int, expectedErr string) {
+				args := []interface{}{template, map[string]any{"state": state}}
+				if count != nil {
+					args = append(args, count)
+				}
+				var matches []client.Object
+				var err error
+				runOp(func() { matches, err = sc.CheckAll(ctx, args...) })
+				Expect(t.Failed()).To(BeFalse(), "expected CheckAll not to fail: %v", t.ErrorLogs)
+				Expect(matches).To(HaveLen(expectedMatches))
+				for _, match := range matches {
+					Expect(match).To(BeAssignableToTypeOf(&corev1.ConfigMap{}))
+				}
+				if expectedErr == "" {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+				}
+			},
+			Entry("should require at least one match by default", "running", nil, 2, ""),
+			Entry("should fail without matches by default", "stopped", nil, 0,
+				"expected at least 1 matching resource(s) but found 0"),
+			Entry("should include mismatches when too few resources match", "pending", sawchain.ExactMatches(2), 1,
+				"v1/ConfigMap/default/web-1"),
+			Entry("should pass with exact matches", "running", sawchain.ExactMatches(2), 2, ""),
+			Entry("should fail with more than exact matches", "running", sawchain.ExactMatches(1), 2,
+				"expected exactly 1 matching resource(s) but found 2: ConfigMap (default/web-1), ConfigMap (default/web-2)"),
+			Entry("should pass with minimum matches", "running", sawchain.MinMatches(2), 2, ""),
+			Entry("should pass with no matches under maximum", "stopped", sawchain.MaxMatches(1), 0, ""),
+			Entry("should fail with more than maximum matches", "running", sawchain.MaxMatches(1), 2,
+				"expected at most 1 matching resource(s) but found 2"),
+			Entry("should pass with matches in range", "running", sawchain.MatchesBetween(2, 3), 2, ""),
+		)
+
+		It("should treat a missing named resource as no matches", func() {
+			var err error
+			runOp(func() {
+				_, err = sc.CheckAll(ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: missing
+  namespace: default
+`, sawchain.MaxMatches(0))
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected CheckAll not to fail: %v", t.ErrorLogs)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return a function that checks on each call", func() {
+			var checkAll func() ([]client.Object, error)
+			runOp(func() {
+				checkAll = sc.CheckAllFunc(ctx, template, map[string]any{"state": "running"}, sawchain.ExactMatches(2))
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected CheckAllFunc not to fail: %v", t.ErrorLogs)
+			Eventually(checkAll).Should(HaveLen(2))
+			runOp(func() {
+				sc.Create(ctx, withLabels(testutil.NewConfigMap("web-4", "default", map[string]string{"state": "running"}), map[string]string{"app": "web"}))
+			})
+			_, err := checkAll()
+			Expect(err).To(MatchError(ContainSubstring("expected exactly 2 matching resource(s) but found 3")))
+		})
+
+		It("should fail for invalid arguments", func() {
+			runOp(func() { sc.CheckAll(ctx, template, sawchain.ExactMatches(1), sawchain.MaxMatches(2)) })
+			Expect(t.Failed()).To(BeTrue(), "expected CheckAll to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("multiple match count arguments provided")))
+		})
+
+		DescribeTable("rejecting invalid match counts",
+			func(count interface{}, expectedErr string) {
+				runOp(func() { sc.CheckAll(ctx, template, count) })
+				Expect(t.Failed()).To(BeTrue(), "expected CheckAll to fail")
+				Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedErr)))
+			},
+			Entry("negative exact matches", sawchain.ExactMatches(-1), "provided match count minimum must not be negative"),
+			Entry("negative minimum matches", sawchain.MinMatches(-1), "provided match count minimum must not be negative"),
+			Entry("negative maximum matches", sawchain.MaxMatches(-1), "provided match count maximum must not be negative"),
+			Entry("inverted range", sawchain.MatchesBetween(3, 1), "provided match count maximum must not be less than minimum"),
+		)
+	})
+
+	Describe("Check Absent", func() {
+		var (
+			t  *MockT
+			c  client.Client
+			sc *sawchain.Sawchain
+		)
+
+		BeforeEach(func() {
+			c = testutil.NewStandardFakeClient()
+			t, sc = newMockSawchain(c, fastTimeout, fastInterval)
+			Expect(c.Create(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}))).To(Succeed())
+		})
+
+		DescribeTable("checking for absence",
+			func(template string, expectedErr string) {
+				var err error
+				runOp(func() { err = sc.CheckAbsent(ctx, template) })
+				Expect(t.Failed()).To(BeFalse(), "expected CheckAbsent not to fail: %v", t.ErrorLogs)
+				if expectedErr == "" {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+				}
+			},
+			Entry("should succeed when the named resource doesn't exist", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: missing
+  namespace: default
+`, ""),
+			Entry("should succeed when the resource exists but a field doesn't match", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+data:
+  key: other
+`, ""),
+			Entry("should fail when the resource matches", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+data:
+  key: value
+`, "expected no matching resources but found 1: ConfigMap (default/test-cm)"),
+			Entry("should fail when an unnamed template matches", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  namespace: default
+data:
+  (key == 'value'): true
+`, "expected no matching resources but found 1"),
+			Entry("should identify matching documents", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: missing
+  namespace: default
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+`, "document 1: expected no matching resources but found 1"),
+		)
+
+		It("should fail immediately for invalid templates", func() {
+			runOp(func() {
+				sc.CheckAbsent(ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ($missing)
+`)
+			})
+			Expect(t.Failed()).To(BeTrue(), "expected CheckAbsent to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("invalid template/bindings")))
+		})
+
+		It("should return a function that checks on each call", func() {
+			template := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+`
+			var checkAbsent func() error
+			runOp(func() { checkAbsent = sc.CheckAbsentFunc(ctx, template) })
+			Expect(t.Failed()).To(BeFalse(), "expected CheckAbsentFunc not to fail: %v", t.ErrorLogs)
+			Expect(checkAbsent()).To(MatchError(ContainSubstring("expected no matching resources")))
+			Expect(c.Delete(ctx, testutil.NewConfigMap("test-cm", "default", nil))).To(Succeed())
+			Expect(checkAbsent()).To(Succeed())
+		})
+	})
+
+	Describe("Eventual Assertions", func() {
+		var (
+			t  *MockT
+			c  client.Client
+			sc *sawchain.Sawchain
+		)
+
+		template := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+data:
+  key: value
+`
+
+		// createLater creates the ConfigMap after a delay shorter than the global timeout.
+		createLater := func() {
+			go func() {
+				defer GinkgoRecover()
+				time.Sleep(fastTimeout / 4)
+				Expect(c.Create(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}))).To(Succeed())
+			}()
+		}
+
+		BeforeEach(func() {
+			c = testutil.NewStandardFakeClient()
+			t, sc = newMockSawchain(c, fastTimeout, fastInterval)
+		})
+
+		It("should eventually succeed within the global timeout", func() {
+			createLater()
+			configMap := &corev1.ConfigMap{}
+			runOp(func() { sc.EventuallyCheck(ctx, configMap, template) })
+			Expect(t.Failed()).To(BeFalse(), "expected EventuallyCheck to succeed: %v", t.ErrorLogs)
+			Expect(configMap.Name).To(Equal("test-cm"))
+		})
+
+		It("should fail when the check doesn't succeed within the timeout", func() {
+			start := time.Now()
+			runOp(func() { sc.EventuallyCheck(ctx, template, "20ms", "5ms") })
+			Expect(time.Since(start)).To(BeNumerically("<", fastTimeout))
+			Expect(t.Failed()).To(BeTrue(), "expected EventuallyCheck to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("check did not succeed within timeout")))
+		})
+
+		It("should consistently succeed for the global timeout", func() {
+			Expect(c.Create(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}))).To(Succeed())
+			start := time.Now()
+			runOp(func() { sc.ConsistentlyCheck(ctx, template) })
+			Expect(time.Since(start)).To(BeNumerically(">=", fastTimeout))
+			Expect(t.Failed()).To(BeFalse(), "expected ConsistentlyCheck to succeed: %v", t.ErrorLogs)
+		})
+
+		It("should fail when the check stops succeeding", func() {
+			Expect(c.Create(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}))).To(Succeed())
+			go func() {
+				defer GinkgoRecover()
+				time.Sleep(fastTimeout / 4)
+				Expect(c.Delete(ctx, testutil.NewConfigMap("test-cm", "default", nil))).To(Succeed())
+			}()
+			runOp(func() { sc.ConsistentlyCheck(ctx, template) })
+			Expect(t.Failed()).To(BeTrue(), "expected ConsistentlyCheck to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("check did not keep succeeding for the whole duration")))
+		})
+
+		It("should eventually match a fetched object", func() {
+			createLater()
+			obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-cm", Namespace: "default"}}
+			var fetched client.Object
+			runOp(func() {
+				fetched = sc.EventuallyMatch(ctx, obj, `
+apiVersion: v1
+kind: ConfigMap
+data:
+  key: ($value)
+`, map[string]any{"value": "value"})
+			})
+			Expect(t.Failed()).To(BeFalse(), "expected EventuallyMatch to succeed: %v", t.ErrorLogs)
+			Expect(fetched).To(BeIdenticalTo(obj))
+			Expect(obj.Data).To(Equal(map[string]string{"key": "value"}))
+		})
+
+		It("should fail when the object doesn't match within the timeout", func() {
+			Expect(c.Create(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "other"}))).To(Succeed())
+			obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-cm", Namespace: "default"}}
+			runOp(func() { sc.EventuallyMatch(ctx, obj, template) })
+			Expect(t.Failed()).To(BeTrue(), "expected EventuallyMatch to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("object did not match template within timeout")))
+		})
+
+		It("should fail for invalid arguments", func() {
+			runOp(func() { sc.EventuallyMatch(ctx, template) })
+			Expect(t.Failed()).To(BeTrue(), "expected EventuallyMatch to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("required argument(s) not provided: Object (client.Object)")))
+		})
+	})
+	Describe("Unsupported Options", func() {
+		var (
+			t  *MockT
+			sc *sawchain.Sawchain
+		)
+
+		template := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+  namespace: default
+`
+
+		BeforeEach(func() {
+			t, sc = newMockSawchain(testutil.NewStandardFakeClient(), fastTimeout, fastInterval)
+		})
+
+		DescribeTable("rejecting options the operation doesn't use",
+			func(operation func(sc *sawchain.Sawchain), expectedErr string) {
+				runOp(func() { operation(sc) })
+				Expect(t.Failed()).To(BeTrue(), "expected operation to fail")
+				Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("invalid arguments")))
+				Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedErr)))
+			},
+			Entry("subresource for Create", func(sc *sawchain.Sawchain) {
+				sc.Create(ctx, template, sawchain.StatusSubresource)
+			}, "unexpected argument type: options.Subresource"),
+			Entry("skip cleanup for Update", func(sc *sawchain.Sawchain) {
+				sc.Update(ctx, template, sawchain.SkipCleanup)
+			}, "unexpected argument type: options.SkipCleanup"),
+			Entry("patch type for Apply", func(sc *sawchain.Sawchain) {
+				sc.Apply(ctx, template, types.MergePatchType)
+			}, "unexpected argument type: types.PatchType"),
+			Entry("client options for Check", func(sc *sawchain.Sawchain) {
+				sc.Check(ctx, template, client.DryRunAll)
+			}, "unexpected argument type: client.dryRunAll"),
+			Entry("match count for Check", func(sc *sawchain.Sawchain) {
+				sc.Check(ctx, template, sawchain.ExactMatches(3))
+			}, "unexpected argument type: options.MatchCount"),
+			Entry("match count for CheckAbsent", func(sc *sawchain.Sawchain) {
+				sc.CheckAbsent(ctx, template, sawchain.MaxMatches(1))
+			}, "unexpected argument type: options.MatchCount"),
+			Entry("merge onto live for Delete", func(sc *sawchain.Sawchain) {
+				sc.Delete(ctx, template, sawchain.MergeOntoLive)
+			}, "unexpected argument type: options.MergeOntoLive"),
+			Entry("client options for Get", func(sc *sawchain.Sawchain) {
+				sc.Get(ctx, template, client.FieldOwner("test"))
+			}, "unexpected argument type: client.FieldOwner"),
+		)
+
+		It("should reject client options for New", func() {
+			t := &MockT{TB: GinkgoTB()}
+			runOp(func() { sawchain.New(t, testutil.NewStandardFakeClient(), client.DryRunAll) })
+			Expect(t.Failed()).To(BeTrue(), "expected New to fail")
+			Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("unexpected argument type: client.dryRunAll")))
+		})
+	})
 })